@@ -1,7 +1,10 @@
 package common
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 )
 
 // APIError define API error when response status is 4xx or 5xx
@@ -9,6 +12,10 @@ type APIError struct {
 	Code     int64  `json:"code"`
 	Message  string `json:"msg"`
 	Response []byte `json:"-"` // Assign the body value when the Code and Message fields are invalid.
+	// StatusCode is the HTTP status of the response
+	StatusCode int `json:"-"`
+	// RetryAfter is the delay from the Retry-After header of 429 and 418 responses, zero when absent
+	RetryAfter time.Duration `json:"-"`
 }
 
 // Error return error code and message
@@ -28,3 +35,18 @@ func IsAPIError(e error) bool {
 	_, ok := e.(*APIError)
 	return ok
 }
+
+// IsRateLimited check if err is an API error of a 429 response, the request weight limit was exceeded
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsBanned check if err is an API error of a 418 response, the IP was banned for ignoring 429 responses
+func IsBanned(err error) bool {
+	return hasStatusCode(err, http.StatusTeapot)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
@@ -2,7 +2,6 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 )
 
 // AccountConfigService get futures account configuration
@@ -12,14 +11,14 @@ type AccountConfigService struct {
 
 // AccountConfig define futures account configuration
 type AccountConfig struct {
-	FeeTier           int   `json:"feeTier"`          // Account commission tier
-	CanTrade          bool  `json:"canTrade"`         // If can trade
-	CanDeposit        bool  `json:"canDeposit"`       // If can transfer in asset
-	CanWithdraw       bool  `json:"canWithdraw"`      // If can transfer out asset
-	DualSidePosition  bool  `json:"dualSidePosition"` // If dual side position is enabled
-	UpdateTime        int64 `json:"updateTime"`       // Reserved property
-	MultiAssetsMargin bool  `json:"multiAssetsMargin"`
-	TradeGroupId      int   `json:"tradeGroupId"`
+	FeeTier           int   `json:"feeTier"`           // Account commission tier
+	CanTrade          bool  `json:"canTrade"`          // If can trade
+	CanDeposit        bool  `json:"canDeposit"`        // If can transfer in asset
+	CanWithdraw       bool  `json:"canWithdraw"`       // If can transfer out asset
+	DualSidePosition  bool  `json:"dualSidePosition"`  // If dual side position is enabled
+	UpdateTime        int64 `json:"updateTime"`        // Reserved property
+	MultiAssetsMargin bool  `json:"multiAssetsMargin"` // If multi-assets mode is enabled
+	TradeGroupId      int   `json:"tradeGroupId"`      // Trade group of the account, -1 when none
 }
 
 // Do send request
@@ -34,7 +33,7 @@ func (s *AccountConfigService) Do(ctx context.Context, opts ...RequestOption) (*
 		return nil, err
 	}
 	res := new(AccountConfig)
-	err = json.Unmarshal(data, res)
+	err = s.c.unmarshal(data, res)
 	if err != nil {
 		return nil, err
 	}
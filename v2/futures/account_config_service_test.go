@@ -49,3 +49,28 @@ func (s *AccountConfigServiceTestSuite) TestGetAccountConfig() {
 	s.r().NoError(err)
 	s.r().Equal(expected, config)
 }
+
+func (s *AccountConfigServiceTestSuite) TestDecodeAccountConfig() {
+	data := []byte(`{
+		"feeTier": 2,
+		"canTrade": true,
+		"canDeposit": false,
+		"canWithdraw": true,
+		"dualSidePosition": false,
+		"updateTime": 0,
+		"multiAssetsMargin": true,
+		"tradeGroupId": 7
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	config, err := s.client.NewGetAccountConfigService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&AccountConfig{
+		FeeTier:           2,
+		CanTrade:          true,
+		CanWithdraw:       true,
+		MultiAssetsMargin: true,
+		TradeGroupId:      7,
+	}, config)
+}
@@ -0,0 +1,49 @@
+package futures
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoMarginBalance is returned when an account has no positive total margin balance
+var ErrNoMarginBalance = errors.New("account has no margin balance")
+
+// LeverageUtilization return totalInitialMargin / totalMarginBalance of the account, zero when it can't be computed
+func LeverageUtilization(account *AccountV3) decimal.Decimal {
+	ratio, _ := LeverageUtilizationWithError(account)
+	return ratio
+}
+
+// LeverageUtilizationWithError return totalInitialMargin / totalMarginBalance of the account,
+// the share of the margin balance locked by positions and open orders
+func LeverageUtilizationWithError(account *AccountV3) (decimal.Decimal, error) {
+	return marginRatio(account, "totalInitialMargin", account.TotalInitialMargin)
+}
+
+// MaintenanceMarginRatio return totalMaintMargin / totalMarginBalance of the account, zero when it can't be computed
+func MaintenanceMarginRatio(account *AccountV3) decimal.Decimal {
+	ratio, _ := MaintenanceMarginRatioWithError(account)
+	return ratio
+}
+
+// MaintenanceMarginRatioWithError return totalMaintMargin / totalMarginBalance of the account,
+// the account is liquidated when it reaches 1
+func MaintenanceMarginRatioWithError(account *AccountV3) (decimal.Decimal, error) {
+	return marginRatio(account, "totalMaintMargin", account.TotalMaintMargin)
+}
+
+func marginRatio(account *AccountV3, name, margin string) (decimal.Decimal, error) {
+	balance, err := parseDecimal("totalMarginBalance", account.TotalMarginBalance)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if !balance.IsPositive() {
+		return decimal.Zero, ErrNoMarginBalance
+	}
+	value, err := parseDecimal(name, margin)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return value.Div(balance), nil
+}
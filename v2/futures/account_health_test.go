@@ -0,0 +1,33 @@
+package futures
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var accountHealthData = []byte(`{
+	"totalInitialMargin": "2500.00000000",
+	"totalMaintMargin": "125.50000000",
+	"totalWalletBalance": "9800.00000000",
+	"totalUnrealizedProfit": "200.00000000",
+	"totalMarginBalance": "10000.00000000",
+	"totalPositionInitialMargin": "2000.00000000",
+	"totalOpenOrderInitialMargin": "500.00000000",
+	"availableBalance": "7500.00000000"
+}`)
+
+func TestLeverageUtilization(t *testing.T) {
+	account := new(AccountV3)
+	require.NoError(t, json.Unmarshal(accountHealthData, account))
+
+	require.Equal(t, "0.25", LeverageUtilization(account).String())
+	require.Equal(t, "0.01255", MaintenanceMarginRatio(account).String())
+
+	_, err := LeverageUtilizationWithError(&AccountV3{TotalInitialMargin: "10", TotalMarginBalance: "0"})
+	require.ErrorIs(t, err, ErrNoMarginBalance)
+	_, err = MaintenanceMarginRatioWithError(&AccountV3{TotalMarginBalance: "10"})
+	require.EqualError(t, err, `invalid totalMaintMargin "": can't convert  to decimal`)
+	require.True(t, MaintenanceMarginRatio(&AccountV3{}).IsZero())
+}
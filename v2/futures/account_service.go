@@ -2,7 +2,6 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 )
 
@@ -25,7 +24,7 @@ func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res
 		return []*Balance{}, err
 	}
 	res = make([]*Balance, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*Balance{}, err
 	}
@@ -57,12 +56,12 @@ func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res
 		"method": http.MethodGet,
 		"params": map[string]interface{}{},
 	}
-	data, err := s.c.call(m, true)
+	data, err := s.c.call(ctx, m, true, opts...)
 	if err != nil {
 		return nil, err
 	}
 	res = new(Account)
-	err = json.Unmarshal(data, res)
+	err = s.c.unmarshal(data, res)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +147,7 @@ func (s *GetAccountV3Service) Do(ctx context.Context, opts ...RequestOption) (re
 		return nil, err
 	}
 	res = new(AccountV3)
-	err = json.Unmarshal(data, res)
+	err = s.c.unmarshal(data, res)
 	if err != nil {
 		return nil, err
 	}
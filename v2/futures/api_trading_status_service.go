@@ -2,10 +2,10 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 )
 
+// ApiTradingStatusService get the futures trading quantitative rules indicators
 type ApiTradingStatusService struct {
 	c      *Client
 	symbol string
@@ -19,7 +19,7 @@ func (service *ApiTradingStatusService) Symbol(symbol string) *ApiTradingStatusS
 
 // Do send request
 // https://developers.binance.com/docs/derivatives/usds-margined-futures/account/rest-api/Futures-Trading-Quantitative-Rules-Indicators
-func (s *ApiTradingStatusService) Do(ctx context.Context, opts ...RequestOption) (res *TradingStatusIndicators, err error) {
+func (s *ApiTradingStatusService) Do(ctx context.Context, opts ...RequestOption) (res *APITradingStatus, err error) {
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/apiTradingStatus",
@@ -32,24 +32,65 @@ func (s *ApiTradingStatusService) Do(ctx context.Context, opts ...RequestOption)
 	if err != nil {
 		return nil, err
 	}
-	res = new(TradingStatusIndicators)
-	err = json.Unmarshal(data, &res)
+	res = new(APITradingStatus)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
-// Trading Status Indicator
-type TradingStatusIndicators struct {
+// APITradingStatus is the trading status of the account, indicators are grouped by symbol,
+// account-wide indicators are under the "ACCOUNT" key
+type APITradingStatus struct {
 	Indicators map[string][]*IndicatorInfo `json:"indicators"`
 	UpdateTime int64                       `json:"updateTime"`
 }
 
+// TradingStatusIndicators is the former name of APITradingStatus
+//
+// Deprecated: use APITradingStatus
+type TradingStatusIndicators = APITradingStatus
+
+// IndicatorInfo is the current value of an indicator and the threshold triggering a trading restriction
 type IndicatorInfo struct {
-	IsLocked           bool    `json:"isLocked"`
-	PlannedRecoverTime int64   `json:"plannedRecoverTime"`
-	Indicator          string  `json:"indicator"`
-	Value              float64 `json:"value"`
-	TriggerValue       float64 `json:"triggerValue"`
+	IsLocked           bool                 `json:"isLocked"`
+	PlannedRecoverTime int64                `json:"plannedRecoverTime"`
+	Indicator          TradingIndicatorType `json:"indicator"`
+	Value              float64              `json:"value"`
+	TriggerValue       float64              `json:"triggerValue"`
+}
+
+// IsLocked return true when trading is restricted by any indicator
+func (s *APITradingStatus) IsLocked() bool {
+	for _, indicators := range s.Indicators {
+		for _, indicator := range indicators {
+			if indicator.IsLocked {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PlannedRecoverTime return the latest planned recover time of the locked indicators, 0 when not locked
+func (s *APITradingStatus) PlannedRecoverTime() int64 {
+	var recoverTime int64
+	for _, indicators := range s.Indicators {
+		for _, indicator := range indicators {
+			if indicator.IsLocked && indicator.PlannedRecoverTime > recoverTime {
+				recoverTime = indicator.PlannedRecoverTime
+			}
+		}
+	}
+	return recoverTime
+}
+
+// SymbolIndicators return the indicators of symbol by indicator type, use "ACCOUNT" for the account-wide ones
+func (s *APITradingStatus) SymbolIndicators(symbol string) map[TradingIndicatorType]*IndicatorInfo {
+	res := make(map[TradingIndicatorType]*IndicatorInfo, len(s.Indicators[symbol]))
+	for _, indicator := range s.Indicators[symbol] {
+		res[indicator.Indicator] = indicator
+	}
+	return res
 }
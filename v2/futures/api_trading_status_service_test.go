@@ -61,7 +61,7 @@ func (s *apiTradingStatusServiceTestSuite) TestGetApiTradingStatus() {
 	res, err := s.client.NewApiTradingStatusService().Symbol(symbol).Do(newContext())
 	s.r().NoError(err)
 
-	e := &TradingStatusIndicators{
+	e := &APITradingStatus{
 		Indicators: map[string][]*IndicatorInfo{
 			"BTCUSDT": {
 				{
@@ -100,7 +100,7 @@ func (s *apiTradingStatusServiceTestSuite) TestGetApiTradingStatus() {
 	s.assertApiTradingStatusEqual(e, res)
 }
 
-func (s *apiTradingStatusServiceTestSuite) assertApiTradingStatusEqual(e, a *TradingStatusIndicators) {
+func (s *apiTradingStatusServiceTestSuite) assertApiTradingStatusEqual(e, a *APITradingStatus) {
 	r := s.r()
 	s.r().Len(e.Indicators, len(a.Indicators))
 	for k := range e.Indicators {
@@ -116,3 +116,66 @@ func (s *apiTradingStatusServiceTestSuite) assertApiTradingStatusEqual(e, a *Tra
 	}
 	r.Equal(e.UpdateTime, a.UpdateTime, "UpdateTime")
 }
+
+func (s *apiTradingStatusServiceTestSuite) TestActiveIndicators() {
+	data := []byte(`{
+		"indicators": {
+			"BTCUSDT": [
+				{
+					"isLocked": false,
+					"plannedRecoverTime": 0,
+					"indicator": "UFR",
+					"value": 0.05,
+					"triggerValue": 0.995
+				},
+				{
+					"isLocked": true,
+					"plannedRecoverTime": 1545741270000,
+					"indicator": "IFER",
+					"value": 0.99,
+					"triggerValue": 0.99
+				}
+			],
+			"ETHUSDT": [
+				{
+					"isLocked": true,
+					"plannedRecoverTime": 1545741870000,
+					"indicator": "GCR",
+					"value": 0.5,
+					"triggerValue": 0.3
+				}
+			],
+			"ACCOUNT": [
+				{
+					"isLocked": false,
+					"plannedRecoverTime": 0,
+					"indicator": "TMV",
+					"value": 10,
+					"triggerValue": 1
+				}
+			]
+		},
+		"updateTime": 1545741270000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	res, err := s.client.NewApiTradingStatusService().Do(newContext())
+	s.r().NoError(err)
+	s.r().True(res.IsLocked())
+	s.r().Equal(int64(1545741870000), res.PlannedRecoverTime())
+
+	btc := res.SymbolIndicators("BTCUSDT")
+	s.r().Len(btc, 2)
+	s.r().False(btc[TradingIndicatorTypeUFR].IsLocked)
+	s.r().Equal(0.05, btc[TradingIndicatorTypeUFR].Value)
+	s.r().Equal(0.995, btc[TradingIndicatorTypeUFR].TriggerValue)
+	s.r().True(btc[TradingIndicatorTypeIFER].IsLocked)
+	s.r().Equal(0.99, res.SymbolIndicators("BTCUSDT")[TradingIndicatorTypeIFER].TriggerValue)
+	s.r().Equal(10.0, res.SymbolIndicators("ACCOUNT")[TradingIndicatorTypeTMV].Value)
+	s.r().Empty(res.SymbolIndicators("BNBUSDT"))
+
+	unlocked := &APITradingStatus{Indicators: map[string][]*IndicatorInfo{"ACCOUNT": res.Indicators["ACCOUNT"]}}
+	s.r().False(unlocked.IsLocked())
+	s.r().Zero(unlocked.PlannedRecoverTime())
+}
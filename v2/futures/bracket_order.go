@@ -0,0 +1,270 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/coin-quant/go-aster/v2/common"
+	"github.com/shopspring/decimal"
+)
+
+// BracketOrder place an entry order then, once it is filled, a stop-loss and take-profit closing it.
+// When one of them is filled the other one is cancelled, emulating an OCO on the client side.
+// An entry cancelled or expired after a partial fill is protected for its executed quantity.
+// Feed it with Handle, e.g. WsUserDataServe(listenKey, bracket.Handle, errHandler).
+// The protective orders are placed from Handle, so they block the user data stream while they are sent.
+type BracketOrder struct {
+	c               *Client
+	symbol          string
+	side            SideType
+	entryType       OrderType
+	quantity        string
+	entryPrice      string
+	stopLossPrice   string
+	takeProfitPrice string
+	workingType     *WorkingType
+	positionSide    *PositionSideType
+
+	mu                 sync.Mutex
+	entryClientOrderID string
+	stopLossID         int64
+	takeProfitID       int64
+	err                error
+	done               chan struct{}
+}
+
+// NewBracketOrder init a bracket order entering symbol on side with a MARKET order by default
+func (c *Client) NewBracketOrder(symbol string, side SideType) *BracketOrder {
+	return &BracketOrder{c: c, symbol: symbol, side: side, entryType: OrderTypeMarket, done: make(chan struct{})}
+}
+
+// EntryType set the type of the entry order, MARKET or LIMIT
+func (b *BracketOrder) EntryType(entryType OrderType) *BracketOrder {
+	b.entryType = entryType
+	return b
+}
+
+// Quantity set the quantity of the entry, stop-loss and take-profit orders
+func (b *BracketOrder) Quantity(quantity string) *BracketOrder {
+	b.quantity = quantity
+	return b
+}
+
+// EntryPrice set the price of a LIMIT entry order
+func (b *BracketOrder) EntryPrice(price string) *BracketOrder {
+	b.entryPrice = price
+	return b
+}
+
+// StopLoss set the stopPrice of the STOP_MARKET stop-loss order
+func (b *BracketOrder) StopLoss(stopPrice string) *BracketOrder {
+	b.stopLossPrice = stopPrice
+	return b
+}
+
+// TakeProfit set the stopPrice of the TAKE_PROFIT_MARKET take-profit order
+func (b *BracketOrder) TakeProfit(stopPrice string) *BracketOrder {
+	b.takeProfitPrice = stopPrice
+	return b
+}
+
+// WorkingType set the price triggering the stop-loss and take-profit orders
+func (b *BracketOrder) WorkingType(workingType WorkingType) *BracketOrder {
+	b.workingType = &workingType
+	return b
+}
+
+// PositionSide set the position side of the entry, stop-loss and take-profit orders, required in hedge mode.
+// The protective orders are reduce-only unless positionSide is LONG or SHORT, which hedge mode doesn't accept with reduceOnly.
+func (b *BracketOrder) PositionSide(positionSide PositionSideType) *BracketOrder {
+	b.positionSide = &positionSide
+	return b
+}
+
+func (b *BracketOrder) validate() error {
+	switch {
+	case b.symbol == "":
+		return ErrOrderSymbolRequired
+	case b.side == "":
+		return ErrOrderSideRequired
+	case b.quantity == "":
+		return ErrOrderQuantityRequired
+	case b.stopLossPrice == "" || b.takeProfitPrice == "":
+		return errors.New("stop-loss and take-profit prices are required")
+	}
+	switch b.entryType {
+	case OrderTypeMarket:
+	case OrderTypeLimit:
+		if b.entryPrice == "" {
+			return ErrOrderPriceRequired
+		}
+	default:
+		return fmt.Errorf("invalid bracket entry type %q", b.entryType)
+	}
+	return nil
+}
+
+// Place validate the bracket and send the entry order
+func (b *BracketOrder) Place(ctx context.Context) (*CreateOrderResponse, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	// register the entry before placing it, its fill may be handled before the response
+	clientOrderID := common.GenerateSwapId()
+	b.mu.Lock()
+	b.entryClientOrderID = clientOrderID
+	b.mu.Unlock()
+
+	s := b.c.NewCreateOrderService().Symbol(b.symbol).Side(b.side).Type(b.entryType).Quantity(b.quantity).
+		NewClientOrderID(clientOrderID)
+	if b.positionSide != nil {
+		s.PositionSide(*b.positionSide)
+	}
+	if b.entryType == OrderTypeLimit {
+		s.TimeInForce(TimeInForceTypeGTC).Price(b.entryPrice)
+	}
+	return s.Do(ctx)
+}
+
+// Handle consume a user data event, events of other orders are ignored
+func (b *BracketOrder) Handle(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeOrderTradeUpdate {
+		return
+	}
+	b.HandleOrderTradeUpdate(&event.OrderTradeUpdate)
+}
+
+// HandleOrderTradeUpdate consume an order trade update, updates of other orders are ignored
+func (b *BracketOrder) HandleOrderTradeUpdate(update *WsOrderTradeUpdate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	select {
+	case <-b.done:
+		return
+	default:
+	}
+	switch {
+	case update.ClientOrderID != "" && update.ClientOrderID == b.entryClientOrderID:
+		b.onEntryUpdate(update)
+	case update.ID == 0:
+		return
+	case update.ID == b.stopLossID:
+		b.onProtectiveOrderUpdate(update, b.takeProfitID)
+	case update.ID == b.takeProfitID:
+		b.onProtectiveOrderUpdate(update, b.stopLossID)
+	}
+}
+
+// onEntryUpdate must be called with mu held, the executed quantity of an entry cancelled or expired after
+// a partial fill is protected
+func (b *BracketOrder) onEntryUpdate(update *WsOrderTradeUpdate) {
+	switch update.Status {
+	case OrderStatusTypeFilled:
+		b.placeProtectiveOrders(b.quantity)
+	case OrderStatusTypeCanceled, OrderStatusTypeExpired:
+		if filled, err := decimal.NewFromString(update.AccumulatedFilledQty); err == nil && filled.IsPositive() {
+			b.placeProtectiveOrders(update.AccumulatedFilledQty)
+			return
+		}
+		b.finish(fmt.Errorf("entry order %d is %s", update.ID, update.Status))
+	case OrderStatusTypeRejected:
+		b.finish(fmt.Errorf("entry order %d is %s", update.ID, update.Status))
+	}
+}
+
+// placeProtectiveOrders must be called with mu held
+func (b *BracketOrder) placeProtectiveOrders(quantity string) {
+	ctx := context.Background()
+	exitSide := SideTypeSell
+	if b.side == SideTypeSell {
+		exitSide = SideTypeBuy
+	}
+	positionSide := b.c.positionSideOrDefault(b.positionSide)
+	place := func(orderType OrderType, stopPrice string) (int64, error) {
+		s := b.c.NewCreateOrderService().Symbol(b.symbol).Side(exitSide).Type(orderType).
+			Quantity(quantity).StopPrice(stopPrice)
+		if positionSide != nil {
+			s.PositionSide(*positionSide)
+		}
+		if positionSide == nil || *positionSide == PositionSideTypeBoth {
+			s.ReduceOnly(true)
+		}
+		if b.workingType != nil {
+			s.WorkingType(*b.workingType)
+		}
+		res, err := s.Do(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return res.OrderID, nil
+	}
+	var err error
+	if b.stopLossID, err = place(OrderTypeStopMarket, b.stopLossPrice); err != nil {
+		b.finish(fmt.Errorf("place stop-loss: %w", err))
+		return
+	}
+	if b.takeProfitID, err = place(OrderTypeTakeProfitMarket, b.takeProfitPrice); err != nil {
+		b.finish(errors.Join(fmt.Errorf("place take-profit: %w", err), b.cancel(b.stopLossID)))
+	}
+}
+
+// onProtectiveOrderUpdate must be called with mu held, sibling is cancelled once the order is filled
+func (b *BracketOrder) onProtectiveOrderUpdate(update *WsOrderTradeUpdate, sibling int64) {
+	switch update.Status {
+	case OrderStatusTypeFilled:
+		b.finish(b.cancel(sibling))
+	case OrderStatusTypeCanceled, OrderStatusTypeExpired, OrderStatusTypeRejected:
+		b.finish(errors.Join(fmt.Errorf("protective order %d is %s", update.ID, update.Status), b.cancel(sibling)))
+	}
+}
+
+func (b *BracketOrder) cancel(orderID int64) error {
+	_, err := b.c.NewCancelOrderService().Symbol(b.symbol).OrderID(strconv.FormatInt(orderID, 10)).Do(context.Background())
+	if err != nil {
+		return fmt.Errorf("cancel order %d: %w", orderID, err)
+	}
+	return nil
+}
+
+// finish must be called with mu held
+func (b *BracketOrder) finish(err error) {
+	b.err = err
+	close(b.done)
+}
+
+// Done return a channel closed once the bracket is over: a protective order was filled and its sibling cancelled,
+// or an order failed, see Err
+func (b *BracketOrder) Done() <-chan struct{} {
+	return b.done
+}
+
+// Err return the error which ended the bracket, nil when it completed normally
+func (b *BracketOrder) Err() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.err
+}
+
+// EntryClientOrderID return the newClientOrderId of the entry order, empty until Place is called
+func (b *BracketOrder) EntryClientOrderID() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.entryClientOrderID
+}
+
+// StopLossID return the orderId of the stop-loss order, 0 until the entry is filled
+func (b *BracketOrder) StopLossID() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.stopLossID
+}
+
+// TakeProfitID return the orderId of the take-profit order, 0 until the entry is filled
+func (b *BracketOrder) TakeProfitID() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.takeProfitID
+}
@@ -0,0 +1,189 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type bracketOrderTestSuite struct {
+	baseTestSuite
+}
+
+func TestBracketOrder(t *testing.T) {
+	suite.Run(t, new(bracketOrderTestSuite))
+}
+
+func (s *bracketOrderTestSuite) replay(b *BracketOrder, frames ...[]byte) {
+	for _, frame := range frames {
+		event := new(WsUserDataEvent)
+		s.r().NoError(json.Unmarshal(frame, event))
+		b.Handle(event)
+	}
+}
+
+// request return the i-th request sent to the mocked client
+func (s *bracketOrderTestSuite) request(i int) *http.Request {
+	return s.client.Calls[i].Arguments.Get(0).(*http.Request)
+}
+
+// requestForm return the params sent in the body of the i-th request
+func (s *bracketOrderTestSuite) requestForm(i int) url.Values {
+	req := s.request(i)
+	body, err := req.GetBody()
+	s.r().NoError(err)
+	data, err := io.ReadAll(body)
+	s.r().NoError(err)
+	form, err := url.ParseQuery(string(data))
+	s.r().NoError(err)
+	return form
+}
+
+func (s *bracketOrderTestSuite) TestStopLossCancelTakeProfit() {
+	s.mockDoOnce([]byte(`{"orderId": 100, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 101, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 102, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 102, "symbol": "BTCUSDT", "status": "CANCELED"}`), nil)
+
+	b := s.client.NewBracketOrder("BTCUSDT", SideTypeBuy).Quantity("0.010").StopLoss("58000").TakeProfit("62000")
+	res, err := b.Place(context.Background())
+	s.r().NoError(err)
+	s.r().Equal(int64(100), res.OrderID)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+
+	s.replay(b,
+		orderTradeUpdateData(100, b.EntryClientOrderID(), OrderStatusTypeNew, "0", "0"),
+		orderTradeUpdateData(100, b.EntryClientOrderID(), OrderStatusTypeFilled, "0.010", "0.010"),
+	)
+	s.r().Equal(int64(101), b.StopLossID())
+	s.r().Equal(int64(102), b.TakeProfitID())
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+	s.r().Equal(http.MethodPost, s.request(1).Method)
+	s.r().Equal(http.MethodPost, s.request(2).Method)
+	s.r().Equal(b.EntryClientOrderID(), s.requestForm(0).Get("newClientOrderId"))
+
+	s.replay(b, orderTradeUpdateData(101, "stopLoss", OrderStatusTypeFilled, "0.010", "0.010"))
+	select {
+	case <-b.Done():
+	case <-time.After(time.Second):
+		s.T().Fatal("bracket not done")
+	}
+	s.r().NoError(b.Err())
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+	cancel := s.request(3)
+	s.r().Equal(http.MethodDelete, cancel.Method)
+	s.r().Equal("/fapi/v3/order", cancel.URL.Path)
+	s.r().Equal("102", cancel.URL.Query().Get("orderId"))
+
+	// updates after completion are ignored
+	s.replay(b, orderTradeUpdateData(102, "takeProfit", OrderStatusTypeCanceled, "0", "0"))
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+}
+
+func (s *bracketOrderTestSuite) TestEntryCanceled() {
+	s.mockDoOnce([]byte(`{"orderId": 100, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+
+	b := s.client.NewBracketOrder("BTCUSDT", SideTypeSell).EntryType(OrderTypeLimit).EntryPrice("61000").
+		Quantity("0.010").StopLoss("62000").TakeProfit("58000")
+	_, err := b.Place(context.Background())
+	s.r().NoError(err)
+
+	s.replay(b, orderTradeUpdateData(100, b.EntryClientOrderID(), OrderStatusTypeCanceled, "0", "0"))
+	<-b.Done()
+	s.r().EqualError(b.Err(), "entry order 100 is CANCELED")
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *bracketOrderTestSuite) TestEntryFilledBeforePlaceReturns() {
+	b := s.client.NewBracketOrder("BTCUSDT", SideTypeBuy).Quantity("0.010").StopLoss("58000").TakeProfit("62000")
+	s.mockDoOnce([]byte(`{"orderId": 100, "symbol": "BTCUSDT", "status": "FILLED"}`), nil)
+	// the fill of the MARKET entry is pushed on the user data stream before its response is received
+	s.client.ExpectedCalls[0].Run(func(args mock.Arguments) {
+		s.replay(b, orderTradeUpdateData(100, b.EntryClientOrderID(), OrderStatusTypeFilled, "0.010", "0.010"))
+	})
+	s.mockDoOnce([]byte(`{"orderId": 101, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 102, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+
+	res, err := b.Place(context.Background())
+	s.r().NoError(err)
+	s.r().Equal(int64(100), res.OrderID)
+	s.r().Equal(int64(101), b.StopLossID())
+	s.r().Equal(int64(102), b.TakeProfitID())
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *bracketOrderTestSuite) TestEntryCanceledPartiallyFilled() {
+	s.mockDoOnce([]byte(`{"orderId": 100, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 101, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 102, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+
+	b := s.client.NewBracketOrder("BTCUSDT", SideTypeBuy).EntryType(OrderTypeLimit).EntryPrice("60000").
+		Quantity("0.010").StopLoss("58000").TakeProfit("62000")
+	_, err := b.Place(context.Background())
+	s.r().NoError(err)
+
+	s.replay(b,
+		orderTradeUpdateData(100, b.EntryClientOrderID(), OrderStatusTypePartiallyFilled, "0.004", "0.004"),
+		orderTradeUpdateData(100, b.EntryClientOrderID(), OrderStatusTypeCanceled, "0", "0.004"),
+	)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+	s.r().Equal(int64(101), b.StopLossID())
+	s.r().Equal(int64(102), b.TakeProfitID())
+	for i := 1; i <= 2; i++ {
+		form := s.requestForm(i)
+		s.r().Equal("0.004", form.Get("quantity"))
+		s.r().Equal("SELL", form.Get("side"))
+		s.r().Equal("true", form.Get("reduceOnly"))
+	}
+	select {
+	case <-b.Done():
+		s.T().Fatal("bracket done before a protective order is filled")
+	default:
+	}
+}
+
+func (s *bracketOrderTestSuite) TestHedgeMode() {
+	s.client.Client = NewClient(s.apiKey, s.secretKey, testPrivateKey, WithPositionMode(true))
+	s.mockDoOnce([]byte(`{"orderId": 100, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 101, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 102, "symbol": "BTCUSDT", "status": "NEW"}`), nil)
+
+	b := s.client.NewBracketOrder("BTCUSDT", SideTypeSell).Quantity("0.010").StopLoss("62000").TakeProfit("58000")
+	_, err := b.Place(context.Background())
+	s.r().ErrorIs(err, ErrPositionSideRequired)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+
+	b.PositionSide(PositionSideTypeShort)
+	_, err = b.Place(context.Background())
+	s.r().NoError(err)
+	s.replay(b, orderTradeUpdateData(100, b.EntryClientOrderID(), OrderStatusTypeFilled, "0.010", "0.010"))
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+	s.r().Equal("SHORT", s.requestForm(0).Get("positionSide"))
+	for i := 1; i <= 2; i++ {
+		form := s.requestForm(i)
+		s.r().Equal("BUY", form.Get("side"))
+		s.r().Equal("SHORT", form.Get("positionSide"))
+		s.r().False(form.Has("reduceOnly"))
+	}
+}
+
+func (s *bracketOrderTestSuite) TestValidate() {
+	_, err := s.client.NewBracketOrder("BTCUSDT", SideTypeBuy).Quantity("0.010").StopLoss("58000").Place(context.Background())
+	s.r().EqualError(err, "stop-loss and take-profit prices are required")
+
+	_, err = s.client.NewBracketOrder("BTCUSDT", SideTypeBuy).EntryType(OrderTypeLimit).Quantity("0.010").
+		StopLoss("58000").TakeProfit("62000").Place(context.Background())
+	s.r().ErrorIs(err, ErrOrderPriceRequired)
+
+	_, err = s.client.NewBracketOrder("BTCUSDT", SideTypeBuy).EntryType(OrderTypeStop).Quantity("0.010").
+		StopLoss("58000").TakeProfit("62000").Place(context.Background())
+	s.r().EqualError(err, `invalid bracket entry type "STOP"`)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
@@ -0,0 +1,66 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// CancelAllMaxParallel bound the number of cancel-all requests sent concurrently by CancelAllOpenOrdersAllSymbols
+var CancelAllMaxParallel = 5
+
+// CancelAllResult define the outcome of the cancel-all request of a symbol
+type CancelAllResult struct {
+	Symbol     string
+	OpenOrders int // number of open orders listed before cancelling
+	Err        error
+}
+
+// CancelAllOpenOrdersAllSymbols list open orders of all symbols and cancel them symbol by symbol,
+// at most CancelAllMaxParallel symbols at a time. Results are sorted by symbol and the returned
+// error joins the errors of the symbols which failed.
+func (c *Client) CancelAllOpenOrdersAllSymbols(ctx context.Context) ([]*CancelAllResult, error) {
+	orders, err := c.NewListOpenOrdersService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	openOrders := make(map[string]int)
+	for _, order := range orders {
+		openOrders[order.Symbol]++
+	}
+	results := make([]*CancelAllResult, 0, len(openOrders))
+	for symbol, n := range openOrders {
+		results = append(results, &CancelAllResult{Symbol: symbol, OpenOrders: n})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Symbol < results[j].Symbol
+	})
+
+	workers := min(max(CancelAllMaxParallel, 1), len(results))
+	jobs := make(chan *CancelAllResult)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for res := range jobs {
+				res.Err = c.NewCancelAllOpenOrdersService().Symbol(res.Symbol).Do(ctx)
+			}
+		}()
+	}
+	for _, res := range results {
+		jobs <- res
+	}
+	close(jobs)
+	wg.Wait()
+
+	var errs []error
+	for _, res := range results {
+		if res.Err != nil {
+			errs = append(errs, fmt.Errorf("cancel open orders of %s: %w", res.Symbol, res.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
@@ -0,0 +1,64 @@
+package futures
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type cancelAllTestSuite struct {
+	baseTestSuite
+}
+
+func TestCancelAll(t *testing.T) {
+	suite.Run(t, new(cancelAllTestSuite))
+}
+
+var cancelAllOpenOrdersData = []byte(`[
+	{"orderId": 1, "symbol": "ETHUSDT", "status": "NEW"},
+	{"orderId": 2, "symbol": "BTCUSDT", "status": "NEW"},
+	{"orderId": 3, "symbol": "BTCUSDT", "status": "PARTIALLY_FILLED"},
+	{"orderId": 4, "symbol": "SOLUSDT", "status": "NEW"}
+]`)
+
+func (s *cancelAllTestSuite) TestCancelAllOpenOrdersAllSymbols() {
+	s.mockDoOnce(cancelAllOpenOrdersData, nil)
+	for i := 0; i < 3; i++ {
+		s.mockDoOnce([]byte(`{"code": 200, "msg": "The operation of cancel all open order is done."}`), nil)
+	}
+
+	results, err := s.client.CancelAllOpenOrdersAllSymbols(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*CancelAllResult{
+		{Symbol: "BTCUSDT", OpenOrders: 2},
+		{Symbol: "ETHUSDT", OpenOrders: 1},
+		{Symbol: "SOLUSDT", OpenOrders: 1},
+	}, results)
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+}
+
+func (s *cancelAllTestSuite) TestCancelAllOpenOrdersAllSymbolsError() {
+	defer func(n int) { CancelAllMaxParallel = n }(CancelAllMaxParallel)
+	CancelAllMaxParallel = 1
+	s.mockDoOnce(cancelAllOpenOrdersData, nil)
+	s.mockDoOnce([]byte(`{}`), nil)
+	s.mockDoOnce(nil, errors.New("dummy error"))
+	s.mockDoOnce([]byte(`{}`), nil)
+
+	results, err := s.client.CancelAllOpenOrdersAllSymbols(newContext())
+	s.r().EqualError(err, "cancel open orders of ETHUSDT: dummy error")
+	s.r().Len(results, 3)
+	s.r().NoError(results[0].Err)
+	s.r().EqualError(results[1].Err, "dummy error")
+	s.r().NoError(results[2].Err)
+}
+
+func (s *cancelAllTestSuite) TestCancelAllOpenOrdersAllSymbolsNoOrder() {
+	s.mockDoOnce([]byte(`[]`), nil)
+
+	results, err := s.client.CancelAllOpenOrdersAllSymbols(newContext())
+	s.r().NoError(err)
+	s.r().Empty(results)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
@@ -1,7 +1,9 @@
 package futures
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
 	"crypto/tls"
 	"encoding/hex"
 	"encoding/json"
@@ -16,6 +18,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bitly/go-simplejson"
@@ -77,6 +81,15 @@ type UserDataEventType string
 // UserDataEventReasonType define reason type for user data event
 type UserDataEventReasonType string
 
+// IncomeType define type of income history
+type IncomeType string
+
+// Period define the interval of the futures data statistics
+type Period string
+
+// TradingIndicatorType define the quantitative rule indicators of the api trading status
+type TradingIndicatorType string
+
 // ForceOrderCloseType define reason type for force order
 type ForceOrderCloseType string
 
@@ -89,6 +102,12 @@ var (
 	BaseApiTestnetUrl = "https://testnet.binancefuture.com"
 )
 
+// DefaultMaxResponseBytes is the response body size limit used when Client.MaxResponseBytes is not set
+var DefaultMaxResponseBytes int64 = 32 << 20
+
+// ErrResponseTooLarge is returned when a response body exceeds the client MaxResponseBytes
+var ErrResponseTooLarge = errors.New("response body too large")
+
 // Global enums
 const (
 	SideTypeBuy  SideType = "BUY"
@@ -199,6 +218,33 @@ const (
 	UserDataEventReasonTypeOptionsPremiumFee   UserDataEventReasonType = "OPTIONS_PREMIUM_FEE"
 	UserDataEventReasonTypeOptionsSettleProfit UserDataEventReasonType = "OPTIONS_SETTLE_PROFIT"
 
+	IncomeTypeTransfer                   IncomeType = "TRANSFER"
+	IncomeTypeWelcomeBonus               IncomeType = "WELCOME_BONUS"
+	IncomeTypeRealizedPnl                IncomeType = "REALIZED_PNL"
+	IncomeTypeFundingFee                 IncomeType = "FUNDING_FEE"
+	IncomeTypeCommission                 IncomeType = "COMMISSION"
+	IncomeTypeInsuranceClear             IncomeType = "INSURANCE_CLEAR"
+	IncomeTypeReferralKickback           IncomeType = "REFERRAL_KICKBACK"
+	IncomeTypeCommissionRebate           IncomeType = "COMMISSION_REBATE"
+	IncomeTypeApiRebate                  IncomeType = "API_REBATE"
+	IncomeTypeMarketMerchantReturnReward IncomeType = "MARKET_MERCHANT_RETURN_REWARD"
+
+	Period5m  Period = "5m"
+	Period15m Period = "15m"
+	Period30m Period = "30m"
+	Period1h  Period = "1h"
+	Period2h  Period = "2h"
+	Period4h  Period = "4h"
+	Period6h  Period = "6h"
+	Period12h Period = "12h"
+	Period1d  Period = "1d"
+
+	TradingIndicatorTypeUFR  TradingIndicatorType = "UFR"
+	TradingIndicatorTypeIFER TradingIndicatorType = "IFER"
+	TradingIndicatorTypeGCR  TradingIndicatorType = "GCR"
+	TradingIndicatorTypeDR   TradingIndicatorType = "DR"
+	TradingIndicatorTypeTMV  TradingIndicatorType = "TMV"
+
 	ForceOrderCloseTypeLiquidation ForceOrderCloseType = "LIQUIDATION"
 	ForceOrderCloseTypeADL         ForceOrderCloseType = "ADL"
 
@@ -232,11 +278,97 @@ func getApiEndpoint() string {
 	return BaseApiMainUrl
 }
 
+// Idle connection pool of the transport created by NewClient, connections to the API host are reused
+// between requests instead of paying a TLS handshake each time
+var (
+	DefaultMaxIdleConns        = 100
+	DefaultMaxIdleConnsPerHost = 32
+	DefaultIdleConnTimeout     = 90 * time.Second
+)
+
+func newTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConns = DefaultMaxIdleConns
+	t.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	t.IdleConnTimeout = DefaultIdleConnTimeout
+	return t
+}
+
+// ClientOption configure a Client created by NewClient
+type ClientOption func(c *Client)
+
+// WithConnectionPool tune the idle connections kept by the transport created by NewClient,
+// it has no effect when the HTTPClient transport is replaced
+func WithConnectionPool(maxIdleConns, maxIdleConnsPerHost int, idleConnTimeout time.Duration) ClientOption {
+	return func(c *Client) {
+		t, ok := c.HTTPClient.Transport.(*http.Transport)
+		if !ok {
+			return
+		}
+		t.MaxIdleConns = maxIdleConns
+		t.MaxIdleConnsPerHost = maxIdleConnsPerHost
+		t.IdleConnTimeout = idleConnTimeout
+	}
+}
+
+// WithTestnet point the client to the testnet regardless of the UseTestnet flag
+func WithTestnet() ClientOption {
+	return func(c *Client) {
+		c.Testnet = true
+		c.BaseURL = BaseApiTestnetUrl
+	}
+}
+
+// WithPositionMode let the client check positionSide against the position mode of the account,
+// hedge mode when dual is true and one-way mode otherwise
+func WithPositionMode(dual bool) ClientOption {
+	return func(c *Client) {
+		c.SetPositionMode(dual)
+	}
+}
+
+// WithDryRun make the client build and sign requests without sending them
+func WithDryRun() ClientOption {
+	return func(c *Client) {
+		c.DryRun = true
+	}
+}
+
+// WithUserAgent set the User-Agent header sent with every request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithDefaultHeaders set headers sent with every request, see Client.DefaultHeaders
+func WithDefaultHeaders(headers map[string]string) ClientOption {
+	return func(c *Client) {
+		c.DefaultHeaders = headers
+	}
+}
+
+// WithDoFunc send the requests of the client through do instead of HTTPClient, mostly useful to
+// intercept requests in tests
+func WithDoFunc(do func(req *http.Request) (*http.Response, error)) ClientOption {
+	return func(c *Client) {
+		c.do = do
+	}
+}
+
+// WithClock make the client take the timestamp and nonce of signed requests from now instead of time.Now,
+// so that signatures can be reproduced in tests
+func WithClock(now func() time.Time) ClientOption {
+	return func(c *Client) {
+		c.now = now
+	}
+}
+
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
-func NewClient(user, signer, PriKeyHex string) *Client {
-	return &Client{
+func NewClient(user, signer, PriKeyHex string, opts ...ClientOption) *Client {
+	c := &Client{
 		User:      user,
 		Signer:    signer,
 		PriKeyHex: PriKeyHex,
@@ -246,10 +378,18 @@ func NewClient(user, signer, PriKeyHex string) *Client {
 		BaseURL:   getApiEndpoint(),
 		UserAgent: "Binance/golang",
 		HTTPClient: &http.Client{
-			Timeout: 15 * time.Second,
+			Transport: newTransport(),
+			Timeout:   15 * time.Second,
 		},
-		Logger: log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		Logger:           log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		Testnet:          UseTestnet,
+		MaxResponseBytes: DefaultMaxResponseBytes,
+		SigningScheme:    SigningSchemePersonalSign,
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
 // NewProxiedClient passing a proxy url
@@ -277,7 +417,9 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 
 type doFunc func(req *http.Request) (*http.Response, error)
 
-// Client define API client
+// Client define API client.
+// A Client is safe for concurrent use by multiple goroutines once configured: its exported fields must be set
+// before it is shared and not modified while requests are in flight.
 type Client struct {
 	User      string
 	Signer    string
@@ -286,13 +428,86 @@ type Client struct {
 	//APIKey    string
 	//SecretKey string
 	//KeyType    string
-	BaseURL    string
-	UserAgent  string
-	HTTPClient *http.Client
-	Debug      bool
-	Logger     *log.Logger
-	TimeOffset int64
-	do         doFunc
+	BaseURL string
+	// UserAgent is sent as the User-Agent header of every request when not empty
+	UserAgent string
+	// DefaultHeaders are sent with every request, headers set by the request itself take precedence
+	DefaultHeaders map[string]string
+	HTTPClient     *http.Client
+	Debug          bool
+	Logger         *log.Logger
+	TimeOffset     int64
+	Testnet        bool
+	// WsBaseURL and WsCombinedBaseURL override the base endpoints of the streams started WithWsClient,
+	// the ones of the client network are used when empty
+	WsBaseURL         string
+	WsCombinedBaseURL string
+	// DryRun sign and build requests of the call path without sending them, see DryRunError
+	DryRun bool
+	// StrictJSON make REST responses with fields unknown to the response types fail to decode. It covers every
+	// response with named fields; klines are positional arrays which have no field to check.
+	StrictJSON bool
+	// MaxResponseBytes bound the response body read by the call path, DefaultMaxResponseBytes when <= 0
+	MaxResponseBytes int64
+	// SigningScheme select how signed requests are signed, SigningSchemePersonalSign by default
+	SigningScheme SigningScheme
+	// EIP712Domain override DefaultEIP712Domain for SigningSchemeEIP712
+	EIP712Domain *EIP712Domain
+	// DefaultPositionSide is sent as positionSide of orders and margin updates which don't set one
+	DefaultPositionSide PositionSideType
+	// DefaultTimeInForce is sent as timeInForce of LIMIT, STOP and TAKE_PROFIT orders which don't set one
+	DefaultTimeInForce TimeInForceType
+	do                 doFunc
+
+	// dualSidePosition is the position mode of the account when known, see SetPositionMode
+	dualSidePosition atomic.Pointer[bool]
+
+	keyMu      sync.Mutex
+	privKey    *ecdsa.PrivateKey
+	privKeyHex string
+
+	listenKeyMu       sync.Mutex
+	listenKeyManagers map[*ListenKeyManager]struct{}
+
+	// now replace time.Now for the timestamp and nonce of signed requests, see WithClock
+	now       func() time.Time
+	lastNonce atomic.Uint64
+}
+
+// DryRunError is returned instead of sending a request when Client.DryRun is set,
+// it hold the request exactly as it would have been transmitted
+type DryRunError struct {
+	Method string
+	URL    string
+	Body   string
+	Header http.Header
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry run: %s %s", e.Method, e.URL)
+}
+
+// WsEndpoint return the base endpoint of the WS streams of the client, WsBaseURL or the one of the client network
+func (c *Client) WsEndpoint() string {
+	if c.WsBaseURL != "" {
+		return c.WsBaseURL
+	}
+	if c.Testnet {
+		return BaseWsTestnetUrl
+	}
+	return BaseWsMainUrl
+}
+
+// WsCombinedEndpoint return the base endpoint of the combined streams of the client, WsCombinedBaseURL or the one
+// of the client network
+func (c *Client) WsCombinedEndpoint() string {
+	if c.WsCombinedBaseURL != "" {
+		return c.WsCombinedBaseURL
+	}
+	if c.Testnet {
+		return BaseCombinedTestnetURL
+	}
+	return BaseCombinedMainURL
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -301,118 +516,23 @@ func (c *Client) debug(format string, v ...interface{}) {
 	}
 }
 
-func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
-	// set request options from user
-	//for _, opt := range opts {
-	//	opt(r)
-	//}
-	//err = r.validate()
-	//if err != nil {
-	//	return err
-	//}
-	//
-	//fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.endpoint)
-	//if r.recvWindow > 0 {
-	//	r.setParam(recvWindowKey, r.recvWindow)
-	//}
-	//if r.secType == secTypeSigned {
-	//	r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
-	//}
-	//queryString := r.query.Encode()
-	//body := &bytes.Buffer{}
-	//bodyString := r.form.Encode()
-	//header := http.Header{}
-	//if r.header != nil {
-	//	header = r.header.Clone()
-	//}
-	//if bodyString != "" {
-	//	header.Set("Content-Type", "application/x-www-form-urlencoded")
-	//	body = bytes.NewBufferString(bodyString)
-	//}
-	//if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
-	//	header.Set("X-MBX-APIKEY", c.APIKey)
-	//}
-	//kt := c.KeyType
-	//if kt == "" {
-	//	kt = common.KeyTypeHmac
-	//}
-	//sf, err := common.SignFunc(kt)
-	//if err != nil {
-	//	return err
-	//}
-	//if r.secType == secTypeSigned {
-	//	raw := fmt.Sprintf("%s%s", queryString, bodyString)
-	//	sign, err := sf(c.SecretKey, raw)
-	//	if err != nil {
-	//		return err
-	//	}
-	//	v := url.Values{}
-	//	v.Set(signatureKey, *sign)
-	//	if queryString == "" {
-	//		queryString = v.Encode()
-	//	} else {
-	//		queryString = fmt.Sprintf("%s&%s", queryString, v.Encode())
-	//	}
-	//}
-	//if queryString != "" {
-	//	fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
-	//}
-	//c.debug("full url: %s, body: %s\n", fullURL, bodyString)
-	//
-	//r.fullURL = fullURL
-	//r.header = header
-	//r.body = body
-	return nil
-}
-
+// callAPI send a request built with the request type through the same path as call, the query and
+// form params are sent as the params of the request. There is no API key, requests of secTypeAPIKey
+// are signed like the ones of secTypeSigned.
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
-	err = c.parseRequest(r, opts...)
-	if err != nil {
-		return []byte{}, &http.Header{}, err
-	}
-	req, err := http.NewRequest(r.method, r.fullURL, r.body)
-	if err != nil {
-		return []byte{}, &http.Header{}, err
-	}
-	req = req.WithContext(ctx)
-	req.Header = r.header
-	c.debug("request: %#v\n", req)
-	f := c.do
-	if f == nil {
-		f = c.HTTPClient.Do
+	for _, opt := range opts {
+		opt(r)
 	}
-	res, err := f(req)
-	if err != nil {
+	if err = r.validate(); err != nil {
 		return []byte{}, &http.Header{}, err
 	}
-	data, err = io.ReadAll(res.Body)
+	params := make(map[string]interface{}, len(r.query)+len(r.form)+1)
+	r.mergeInto(params)
+	data, h, err := c.execute(ctx, r.method, r.endpoint, params, r.secType != secTypeNone, r.header)
 	if err != nil {
 		return []byte{}, &http.Header{}, err
 	}
-	defer func() {
-		cerr := res.Body.Close()
-		// Only overwrite the returned error if the original error was nil and an
-		// error occurred while closing the body.
-		if err == nil && cerr != nil {
-			err = cerr
-		}
-	}()
-	c.debug("response: %#v\n", res)
-	c.debug("response body: %s\n", string(data))
-	c.debug("response status code: %d\n", res.StatusCode)
-
-	if res.StatusCode >= http.StatusBadRequest {
-		//apiErr := new(common.APIError)
-		//e := json.Unmarshal(data, apiErr)
-		//if e != nil {
-		//	c.debug("failed to unmarshal json: %s\n", e)
-		//}
-		//if !apiErr.IsValid() {
-		//	apiErr.Response = data
-		//}
-		//return nil, &res.Header, apiErr
-	}
-	return data, &res.Header, nil
+	return data, &h, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -421,6 +541,19 @@ func (c *Client) SetApiEndpoint(url string) *Client {
 	return c
 }
 
+// SetWsEndpoint set the base endpoint of the WS streams started WithWsClient, an empty url restores the default
+func (c *Client) SetWsEndpoint(url string) *Client {
+	c.WsBaseURL = url
+	return c
+}
+
+// SetWsCombinedEndpoint set the base endpoint of the combined streams started WithWsClient, an empty url restores
+// the default
+func (c *Client) SetWsCombinedEndpoint(url string) *Client {
+	c.WsCombinedBaseURL = url
+	return c
+}
+
 // NewPingService init ping service
 func (c *Client) NewPingService() *PingService {
 	return &PingService{c: c}
@@ -780,16 +913,101 @@ func (c *Client) NewApiTradingStatusService() *ApiTradingStatusService {
 
 // sign 将在 params 中添加 timestamp, recvWindow, user, signer, signature
 func (c *Client) sign(params map[string]interface{}, nonce uint64) error {
-	// 添加 recvWindow 和 timestamp (毫秒)
-	params["recvWindow"] = "50000"
-	timestamp := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
-	//params["timestamp"] = "1759212310710"
-	params["timestamp"] = timestamp
+	c.addSignParams(params)
+	return c.signParams(params, nonce)
+}
+
+// addSignParams 添加 recvWindow 和 timestamp (毫秒)，已设置的保持不变
+func (c *Client) addSignParams(params map[string]interface{}) {
+	if _, ok := params[recvWindowKey]; !ok {
+		params[recvWindowKey] = "50000"
+	}
+	if _, ok := params[timestampKey]; !ok {
+		params[timestampKey] = strconv.FormatInt(c.currentTime().UnixMilli(), 10)
+	}
+}
+
+// BuildSignPayload return the string signed for params, after recvWindow and timestamp are added the way
+// signed requests do. It doesn't modify params and is meant to debug signatures rejected by the exchange.
+func (c *Client) BuildSignPayload(params map[string]interface{}) (string, error) {
+	payload := make(map[string]interface{}, len(params)+2)
+	for k, v := range params {
+		payload[k] = v
+	}
+	c.addSignParams(payload)
+	return normalizeAndStringify(payload)
+}
+
+// ethSignedHashPrefix 是 32 字节 hash 的 personal_sign 前缀
+var ethSignedHashPrefix = []byte("\x19Ethereum Signed Message:\n32")
+
+// signParams 对 params 签名，并在 params 中添加 user, signer, signature, nonce
+func (c *Client) signParams(params map[string]interface{}, nonce uint64) error {
+	msgHash, err := c.signHash(params, nonce)
+	if err != nil {
+		return err
+	}
+	privKey, err := c.privateKey()
+	if err != nil {
+		return err
+	}
+
+	// Sign the hash (returns 65 bytes: R(32)|S(32)|V(1))
+	sig, err := crypto.Sign(msgHash.Bytes(), privKey)
+	if err != nil {
+		return fmt.Errorf("sign error: %w", err)
+	}
+
+	// crypto.Sign returns v as 0/1 in last byte — convert to 27/28
+	if len(sig) != 65 {
+		return fmt.Errorf("unexpected signature length: %d", len(sig))
+	}
+	sig[64] += 27
+
+	// hex-encode with 0x prefix
+	sigHex := "0x" + hex.EncodeToString(sig)
+
+	// 将 user、signer、signature 插入 params
+	params["user"] = c.User
+	params["signer"] = c.Signer
+	params["signature"] = sigHex
+
+	//把 nonce 也放回 params
+	params["nonce"] = nonce
+
+	//fmt.Println("signature:", hex.EncodeToString(sig))
+
+	return nil
+}
+
+// privateKey return the parsed PriKeyHex, it is parsed once and cached until PriKeyHex changes
+func (c *Client) privateKey() (*ecdsa.PrivateKey, error) {
+	c.keyMu.Lock()
+	defer c.keyMu.Unlock()
+	if c.privKey != nil && c.privKeyHex == c.PriKeyHex {
+		return c.privKey, nil
+	}
+	privKey, err := crypto.HexToECDSA(strings.TrimPrefix(c.PriKeyHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	c.privKey, c.privKeyHex = privKey, c.PriKeyHex
+	return privKey, nil
+}
 
+// signHash 返回 params 待签名的消息 hash（EIP-191 或 EIP-712，取决于 SigningScheme）
+func (c *Client) signHash(params map[string]interface{}, nonce uint64) (eth.Hash, error) {
 	// 先做确定性的序列化（递归按 key 排序）
 	trimmed, err := normalizeAndStringify(params)
 	if err != nil {
-		return err
+		return eth.Hash{}, err
+	}
+	switch c.SigningScheme {
+	case SigningSchemePersonalSign, "":
+	case SigningSchemeEIP712:
+		return c.eip712Hash(trimmed, nonce)
+	default:
+		return eth.Hash{}, fmt.Errorf("unknown signing scheme %q", c.SigningScheme)
 	}
 	// trimmed 是 string，作为第一个 ABI 参数
 	// 构造 ABI: (string, address, address, uint256)
@@ -802,15 +1020,15 @@ func (c *Client) sign(params map[string]interface{}, nonce uint64) error {
 	// 定义 abi types
 	tString, err := abi.NewType("string", "", nil)
 	if err != nil {
-		return err
+		return eth.Hash{}, err
 	}
 	tAddress, err := abi.NewType("address", "", nil)
 	if err != nil {
-		return err
+		return eth.Hash{}, err
 	}
 	tUint256, err := abi.NewType("uint256", "", nil)
 	if err != nil {
-		return err
+		return eth.Hash{}, err
 	}
 	arguments := abi.Arguments{
 		{Type: tString},
@@ -822,7 +1040,7 @@ func (c *Client) sign(params map[string]interface{}, nonce uint64) error {
 	// Pack
 	packed, err := arguments.Pack(argString, addrUser, addrSigner, nonceBig)
 	if err != nil {
-		return fmt.Errorf("abi pack error: %w", err)
+		return eth.Hash{}, fmt.Errorf("abi pack error: %w", err)
 	}
 
 	//fmt.Println(hex.EncodeToString(packed))
@@ -832,100 +1050,184 @@ func (c *Client) sign(params map[string]interface{}, nonce uint64) error {
 
 	//fmt.Println(hex.EncodeToString(hash))
 
-	prefixedMsg := fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(hash), hash)
+	// 2. EIP-191 personal_sign: keccak256(prefix + 32 字节 hash 原始字节)
+	return crypto.Keccak256Hash(ethSignedHashPrefix, hash), nil
+}
 
-	// 2. keccak256 哈希
-	msgHash := crypto.Keccak256Hash([]byte(prefixedMsg))
-	// Load private key
-	privKey, err := crypto.HexToECDSA(strings.TrimPrefix(c.PriKeyHex, "0x"))
+func (c *Client) call(ctx context.Context, api map[string]interface{}, sign bool, opts ...RequestOption) ([]byte, error) {
+	// 复制一份 params，以免修改全局模板
+	params := cloneInterface(api["params"])
+	paramsMap, ok := params.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("params must be map[string]interface{}")
+	}
+	// 发送请求
+	urlPath, _ := api["url"].(string)
+	method, _ := api["method"].(string)
+	r := &request{method: method, endpoint: urlPath}
+	for _, opt := range opts {
+		opt(r)
+	}
+	r.mergeInto(paramsMap)
+	respBody, _, err := c.execute(ctx, method, urlPath, paramsMap, sign, r.header)
+	return respBody, err
+}
+
+// execute sign params when sign is set and send them to endpoint, header hold the headers of the request
+// and the headers of the response are returned. A status >= 400 is returned as a *common.APIError.
+func (c *Client) execute(ctx context.Context, method, endpoint string, params map[string]interface{}, sign bool, header http.Header) ([]byte, http.Header, error) {
+	if sign {
+		// sign 会修改 params（加入 user, signer, signature, timestamp, recvWindow）
+		if err := c.sign(params, c.genNonce()); err != nil {
+			return nil, nil, err
+		}
+	}
+	fullUrl := strings.TrimRight(c.BaseURL, "/") + endpoint
+	respBody, respHeader, statusCode, err := c.send(ctx, fullUrl, method, params, header)
 	if err != nil {
-		return fmt.Errorf("invalid private key: %w", err)
+		return nil, respHeader, err
 	}
+	if statusCode >= http.StatusBadRequest {
+		return nil, respHeader, c.apiError(statusCode, respHeader, respBody)
+	}
+	return respBody, respHeader, nil
+}
 
-	// Sign the hash (returns 65 bytes: R(32)|S(32)|V(1))
-	sig, err := crypto.Sign(msgHash.Bytes(), privKey)
+// stream sign params when sign is set and send them to endpoint like execute, but pass the body of a
+// successful response to decode instead of reading it into memory
+func (c *Client) stream(ctx context.Context, method, endpoint string, params map[string]interface{}, sign bool, decode func(r io.Reader) error) error {
+	if sign {
+		if err := c.sign(params, c.genNonce()); err != nil {
+			return err
+		}
+	}
+	fullUrl := strings.TrimRight(c.BaseURL, "/") + endpoint
+	req, err := c.newHTTPRequest(ctx, fullUrl, method, params, nil)
 	if err != nil {
-		return fmt.Errorf("sign error: %w", err)
+		return err
+	}
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		respBody, err := c.readBody(req, resp)
+		if err != nil {
+			return err
+		}
+		return c.apiError(resp.StatusCode, resp.Header, respBody)
 	}
+	return decode(resp.Body)
+}
 
-	// crypto.Sign returns v as 0/1 in last byte — convert to 27/28
-	if len(sig) != 65 {
-		return fmt.Errorf("unexpected signature length: %d", len(sig))
+// apiError build the error of a response with an error status code
+func (c *Client) apiError(statusCode int, header http.Header, body []byte) error {
+	apiErr := new(common.APIError)
+	e := json.Unmarshal(body, apiErr)
+	if e != nil {
+		c.debug("failed to unmarshal json: %s\n", e)
 	}
-	sig[64] += 27
+	if !apiErr.IsValid() {
+		apiErr.Response = body
+	}
+	apiErr.StatusCode = statusCode
+	apiErr.RetryAfter = parseRetryAfter(header.Get("Retry-After"))
+	return apiErr
+}
 
-	// hex-encode with 0x prefix
-	sigHex := "0x" + hex.EncodeToString(sig)
+// parseRetryAfter parse a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
 
-	// 将 user、signer、signature 插入 params
-	params["user"] = c.User
-	params["signer"] = c.Signer
-	params["signature"] = sigHex
+// retryDelay return the delay to wait before retrying after err, the Retry-After of a rate limited
+// or banned error when it is longer than delay
+func retryDelay(err error, delay time.Duration) time.Duration {
+	var apiErr *common.APIError
+	if (common.IsRateLimited(err) || common.IsBanned(err)) && errors.As(err, &apiErr) && apiErr.RetryAfter > delay {
+		return apiErr.RetryAfter
+	}
+	return delay
+}
 
-	//把 nonce 也放回 params
-	params["nonce"] = nonce
+// transportError is an error of the HTTP round trip of a request, e.g. a reset connection or a timeout
+type transportError struct {
+	err error
+}
 
-	//fmt.Println("signature:", hex.EncodeToString(sig))
+func (e *transportError) Error() string {
+	return e.err.Error()
+}
 
-	return nil
+func (e *transportError) Unwrap() error {
+	return e.err
 }
 
-func (c *Client) call(api map[string]interface{}, sign bool) ([]byte, error) {
-	// 复制一份 params，以免修改全局模板
-	params := cloneInterface(api["params"])
-	paramsMap, ok := params.(map[string]interface{})
-	if !ok {
-		return nil, errors.New("params must be map[string]interface{}")
+// isRetryable report whether a request failed with err may succeed when sent again:
+// transport errors, rate limited and banned responses and server errors
+func isRetryable(err error) bool {
+	var te *transportError
+	if errors.As(err, &te) {
+		return true
 	}
-	if sign {
-		nonce := genNonce()
-		//fmt.Println("nonce:", nonce)
-		// sign 会修改 paramsMap（加入 user, signer, signature, timestamp, recvWindow）
-		if err := c.sign(paramsMap, nonce); err != nil {
-			return nil, err
-		}
+	var apiErr *common.APIError
+	if errors.As(err, &apiErr) {
+		return common.IsRateLimited(err) || common.IsBanned(err) || apiErr.StatusCode >= http.StatusInternalServerError
 	}
-	// 发送请求
-	urlPath, _ := api["url"].(string)
-	method, _ := api["method"].(string)
-	fullUrl := strings.TrimRight(c.BaseURL, "/") + urlPath
-	respBody, statusCode, err := c.send(fullUrl, method, paramsMap)
+	return false
+}
+
+// send HTTP 请求：POST/PUT -> form body; GET/DELETE -> params放 querystring; header 为请求自带的 header
+func (c *Client) send(ctx context.Context, fullUrl string, method string, params map[string]interface{}, header http.Header) ([]byte, http.Header, int, error) {
+	req, err := c.newHTTPRequest(ctx, fullUrl, method, params, header)
 	if err != nil {
-		return nil, err
+		return nil, nil, 0, err
 	}
-	//fmt.Printf("HTTP %d response: %s\n", statusCode, respBody)
-	if statusCode >= http.StatusBadRequest {
-		apiErr := new(common.APIError)
-		e := json.Unmarshal(respBody, apiErr)
-		if e != nil {
-			c.debug("failed to unmarshal json: %s\n", e)
-		}
-		if !apiErr.IsValid() {
-			apiErr.Response = respBody
-		}
-		return nil, apiErr
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, nil, 0, err
 	}
-	return respBody, err
+	defer resp.Body.Close()
+	respBody, err := c.readBody(req, resp)
+	if err != nil {
+		return nil, resp.Header, resp.StatusCode, err
+	}
+	return respBody, resp.Header, resp.StatusCode, nil
 }
 
-// send HTTP 请求：POST -> body JSON; GET/DELETE -> params放 querystring
-func (c *Client) send(fullUrl string, method string, params map[string]interface{}) ([]byte, int, error) {
+// newHTTPRequest build the HTTP request of send and stream, a DryRunError is returned instead when DryRun is set
+func (c *Client) newHTTPRequest(ctx context.Context, fullUrl string, method string, params map[string]interface{}, header http.Header) (*http.Request, error) {
 	method = strings.ToUpper(method)
+	var req *http.Request
+	var body string
+	var err error
 	switch method {
-	case "POST":
+	case "POST", "PUT":
 		form := url.Values{}
 		for k, v := range params {
 			form.Set(k, fmt.Sprintf("%v", v)) // interface{} -> string
 		}
-		req, err := http.NewRequest("POST", fullUrl, strings.NewReader(form.Encode()))
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-		resp, err := c.HTTPClient.Do(req)
+		body = form.Encode()
+		req, err = http.NewRequestWithContext(ctx, method, fullUrl, strings.NewReader(body))
 		if err != nil {
-			return nil, 0, err
+			return nil, err
 		}
-		defer resp.Body.Close()
-		body, _ := io.ReadAll(resp.Body)
-		return body, resp.StatusCode, nil
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	case "GET", "DELETE":
 		// 把 params 放到 querystring（递归转成 key=val 的方式；此处做最简单的 flat 化）
 		q := url.Values{}
@@ -933,17 +1235,121 @@ func (c *Client) send(fullUrl string, method string, params map[string]interface
 		u, _ := url.Parse(fullUrl)
 		u.RawQuery = q.Encode()
 		//fmt.Println(u.String())
-		req, _ := http.NewRequest(method, u.String(), nil)
-		resp, err := c.HTTPClient.Do(req)
+		req, err = http.NewRequestWithContext(ctx, method, u.String(), nil)
 		if err != nil {
-			return nil, 0, err
+			return nil, err
 		}
-		defer resp.Body.Close()
-		body, _ := io.ReadAll(resp.Body)
-		return body, resp.StatusCode, nil
 	default:
-		return nil, 0, fmt.Errorf("unsupported http method: %s", method)
+		return nil, fmt.Errorf("unsupported http method: %s", method)
 	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	c.setDefaultHeaders(req.Header)
+	if requestID, ok := RequestIDFromContext(ctx); ok {
+		req.Header.Set(RequestIDHeader, requestID)
+		c.debug("request %s: %s %s\n", requestID, method, req.URL.Path)
+	}
+	if c.DryRun {
+		return nil, &DryRunError{Method: method, URL: req.URL.String(), Body: body, Header: req.Header}
+	}
+	return req, nil
+}
+
+// readBody read the body of resp, up to MaxResponseBytes
+func (c *Client) readBody(req *http.Request, resp *http.Response) ([]byte, error) {
+	limit := c.MaxResponseBytes
+	if limit <= 0 {
+		limit = DefaultMaxResponseBytes
+	}
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("read response body of %s %s: %w", req.Method, req.URL.Path, err)
+	}
+	if int64(len(respBody)) > limit {
+		return nil, fmt.Errorf("read response body of %s %s: %w, limit is %d bytes", req.Method, req.URL.Path, ErrResponseTooLarge, limit)
+	}
+	return respBody, nil
+}
+
+// Close close the listenKey managers started from the client and the idle connections of HTTPClient,
+// for clients that are discarded while the process keeps running
+func (c *Client) Close() error {
+	c.listenKeyMu.Lock()
+	managers := make([]*ListenKeyManager, 0, len(c.listenKeyManagers))
+	for m := range c.listenKeyManagers {
+		managers = append(managers, m)
+	}
+	c.listenKeyMu.Unlock()
+	var errs []error
+	for _, m := range managers {
+		if err := m.Close(context.Background()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if c.HTTPClient != nil {
+		c.HTTPClient.CloseIdleConnections()
+	}
+	return errors.Join(errs...)
+}
+
+// doRequest send req with the do func set by WithDoFunc, or HTTPClient. Errors are returned as transportError.
+func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+	if c.do != nil {
+		resp, err = c.do(req)
+	} else {
+		resp, err = c.HTTPClient.Do(req)
+	}
+	if err != nil {
+		return nil, &transportError{err: err}
+	}
+	return resp, nil
+}
+
+// setDefaultHeaders set UserAgent and DefaultHeaders on header, keeping the headers already set
+func (c *Client) setDefaultHeaders(header http.Header) {
+	if c.UserAgent != "" && header.Get("User-Agent") == "" {
+		header.Set("User-Agent", c.UserAgent)
+	}
+	for k, v := range c.DefaultHeaders {
+		if header.Get(k) == "" {
+			header.Set(k, v)
+		}
+	}
+}
+
+// positionSideOrDefault return positionSide, or DefaultPositionSide when it is not set
+func (c *Client) positionSideOrDefault(positionSide *PositionSideType) *PositionSideType {
+	if positionSide != nil || c.DefaultPositionSide == "" {
+		return positionSide
+	}
+	defaultPositionSide := c.DefaultPositionSide
+	return &defaultPositionSide
+}
+
+// timeInForceOrDefault return timeInForce, or DefaultTimeInForce when it is not set and orderType takes a timeInForce
+func (c *Client) timeInForceOrDefault(timeInForce *TimeInForceType, orderType OrderType) *TimeInForceType {
+	if timeInForce != nil || c.DefaultTimeInForce == "" {
+		return timeInForce
+	}
+	switch orderType {
+	case OrderTypeLimit, OrderTypeStop, OrderTypeTakeProfit:
+		defaultTimeInForce := c.DefaultTimeInForce
+		return &defaultTimeInForce
+	}
+	return nil
+}
+
+// unmarshal decode a REST response into v, rejecting unknown fields when StrictJSON is set
+func (c *Client) unmarshal(data []byte, v interface{}) error {
+	if !c.StrictJSON {
+		return json.Unmarshal(data, v)
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	return d.Decode(v)
 }
 
 // flattenParams 将 map 递归展平成 query params
@@ -1058,7 +1464,38 @@ func cloneInterface(v interface{}) interface{} {
 	return out
 }
 
+// currentTime return the time of the clock set by WithClock, time.Now by default
+func (c *Client) currentTime() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+// genNonce return the nonce of a signed request, from the clock of the client when set by WithClock
+// and strictly increasing for the client
+func (c *Client) genNonce() uint64 {
+	if c.now == nil {
+		return genNonce()
+	}
+	return nextNonce(&c.lastNonce, uint64(c.now().UnixMicro()))
+}
+
+// lastNonce is the last nonce returned by genNonce
+var lastNonce atomic.Uint64
+
+// genNonce return the current time in microseconds, strictly increasing across goroutines
+// so that concurrent requests signed within the same microsecond never share a nonce
 func genNonce() uint64 {
-	micro := time.Now().UnixMicro()
-	return uint64(micro)
+	return nextNonce(&lastNonce, uint64(time.Now().UnixMicro()))
+}
+
+func nextNonce(last *atomic.Uint64, now uint64) uint64 {
+	for {
+		prev := last.Load()
+		nonce := max(now, prev+1)
+		if last.CompareAndSwap(prev, nonce) {
+			return nonce
+		}
+	}
 }
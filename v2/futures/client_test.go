@@ -3,10 +3,21 @@ package futures
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
 
+	"github.com/coin-quant/go-aster/v2/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -31,6 +42,7 @@ func (s *baseTestSuite) SetupTest() {
 
 func (s *baseTestSuite) mockDo(data []byte, err error, statusCode ...int) {
 	s.client.Client.do = s.client.do
+	s.client.HTTPClient.Transport = roundTripperFunc(s.client.do)
 	code := http.StatusOK
 	if len(statusCode) > 0 {
 		code = statusCode[0]
@@ -38,6 +50,21 @@ func (s *baseTestSuite) mockDo(data []byte, err error, statusCode ...int) {
 	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(data, code), err)
 }
 
+// mockDoOnce queue a response for a single request, queued responses are returned in order
+func (s *baseTestSuite) mockDoOnce(data []byte, err error, statusCode ...int) {
+	s.client.Client.do = s.client.do
+	s.client.HTTPClient.Transport = roundTripperFunc(s.client.do)
+	if err != nil {
+		s.client.On("do", anyHTTPRequest()).Return((*http.Response)(nil), err).Once()
+		return
+	}
+	code := http.StatusOK
+	if len(statusCode) > 0 {
+		code = statusCode[0]
+	}
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(data, code), nil).Once()
+}
+
 func (s *baseTestSuite) assertDo() {
 	s.client.AssertCalled(s.T(), "do", anyHTTPRequest())
 }
@@ -46,11 +73,35 @@ func (s *baseTestSuite) assertReq(f func(r *request)) {
 	s.client.assertReq = f
 }
 
+// assertRequestEqual compare the params sent in the body or the query string of a, the signed call path
+// send all of them in one place depending on the method. Expected signed requests, see newSignedRequest,
+// must carry the params added by the signer.
 func (s *baseTestSuite) assertRequestEqual(e, a *request) {
-	s.assertURLValuesEqual(e.query, a.query)
-	s.assertURLValuesEqual(e.form, a.form)
+	expected := mergeURLValues(e.query, e.form)
+	actual := mergeURLValues(a.query, a.form)
+	if expected.Has(signatureKey) {
+		expected.Set("user", s.client.User)
+		expected.Set("signer", s.client.Signer)
+		for _, k := range []string{"nonce", recvWindowKey} {
+			if !expected.Has(k) {
+				expected.Set(k, "")
+			}
+		}
+	}
+	s.assertURLValuesEqual(expected, actual)
+}
+
+func mergeURLValues(values ...url.Values) url.Values {
+	merged := url.Values{}
+	for _, v := range values {
+		for k, vs := range v {
+			merged[k] = append(merged[k], vs...)
+		}
+	}
+	return merged
 }
 
+// assertURLValuesEqual compare e and a, the params set by the signer only need to be present when e leave them empty
 func (s *baseTestSuite) assertURLValuesEqual(e, a url.Values) {
 	var eKeys, aKeys []string
 	for k := range e {
@@ -60,12 +111,14 @@ func (s *baseTestSuite) assertURLValuesEqual(e, a url.Values) {
 		aKeys = append(aKeys, k)
 	}
 	r := s.r()
-	r.Len(aKeys, len(eKeys))
+	r.ElementsMatch(eKeys, aKeys)
 	for k := range a {
 		switch k {
-		case timestampKey, signatureKey:
-			r.NotEmpty(a.Get(k))
-			continue
+		case timestampKey, signatureKey, recvWindowKey, "nonce":
+			if e.Get(k) == "" {
+				r.NotEmpty(a.Get(k), k)
+				continue
+			}
 		}
 		r.Equal(e.Get(k), a.Get(k), k)
 	}
@@ -105,8 +158,18 @@ func newSignedRequest() *request {
 	})
 }
 
+// testPrivateKey is a throwaway key used to sign mocked requests
+const testPrivateKey = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+
 type assertReqFunc func(r *request)
 
+// roundTripperFunc routes requests sent through HTTPClient to the mocked do
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 type mockedClient struct {
 	mock.Mock
 	*Client
@@ -115,7 +178,7 @@ type mockedClient struct {
 
 func newMockedClient(apiKey, secretKey string) *mockedClient {
 	m := new(mockedClient)
-	m.Client = NewClient(apiKey, secretKey)
+	m.Client = NewClient(apiKey, secretKey, testPrivateKey)
 	return m
 }
 
@@ -158,3 +221,518 @@ func (s *baseTestSuite) assertTradeV3Equal(e, a *TradeV3) {
 	r.Equal(e.IsMaker, a.IsMaker, "IsMaker")
 	r.Equal(e.IsBestMatch, a.IsBestMatch, "IsBestMatch")
 }
+
+func TestNewClientTestnet(t *testing.T) {
+	mainnet := NewClient("user", "signer", testPrivateKey)
+	testnet := NewClient("user", "signer", testPrivateKey, WithTestnet())
+
+	require.False(t, mainnet.Testnet)
+	require.Equal(t, BaseApiMainUrl, mainnet.BaseURL)
+	require.Equal(t, BaseWsMainUrl, mainnet.WsEndpoint())
+	require.True(t, testnet.Testnet)
+	require.Equal(t, BaseApiTestnetUrl, testnet.BaseURL)
+	require.Equal(t, BaseWsTestnetUrl, testnet.WsEndpoint())
+	require.Equal(t, BaseCombinedTestnetURL, testnet.WsCombinedEndpoint())
+	require.NotEqual(t, mainnet.BaseURL, testnet.BaseURL)
+}
+
+func TestDryRun(t *testing.T) {
+	c := NewClient("0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002", testPrivateKey, WithDryRun())
+	c.HTTPClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	_, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Quantity("0.01").Price("60000").Do(context.Background())
+	var dryRun *DryRunError
+	require.ErrorAs(t, err, &dryRun)
+	require.Equal(t, http.MethodPost, dryRun.Method)
+	require.Equal(t, c.BaseURL+"/fapi/v3/order", dryRun.URL)
+	require.Equal(t, "application/x-www-form-urlencoded", dryRun.Header.Get("Content-Type"))
+
+	form, err := url.ParseQuery(dryRun.Body)
+	require.NoError(t, err)
+	require.Equal(t, "BTCUSDT", form.Get("symbol"))
+	require.Equal(t, "BUY", form.Get("side"))
+	require.Equal(t, "LIMIT", form.Get("type"))
+	require.Equal(t, "0.01", form.Get("quantity"))
+	require.Equal(t, "60000", form.Get("price"))
+	for _, k := range []string{"user", "signer", "nonce", "signature", "timestamp", "recvWindow"} {
+		require.NotEmpty(t, form.Get(k), k)
+	}
+}
+
+func TestSendTruncatedBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "100")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"serverTime":`))
+		conn, _, err := w.(http.Hijacker).Hijack()
+		require.NoError(t, err)
+		_ = conn.Close()
+	}))
+	defer server.Close()
+
+	c := NewClient("user", "signer", testPrivateKey).SetApiEndpoint(server.URL)
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		_, err := c.call(context.Background(), map[string]interface{}{
+			"url":    "/fapi/v1/time",
+			"method": method,
+			"params": map[string]interface{}{},
+		}, false)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF, method)
+		require.ErrorContains(t, err, "read response body of "+method+" /fapi/v1/time", method)
+	}
+}
+
+func TestSendMaxResponseBytes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		chunk := bytes.Repeat([]byte("a"), 1024)
+		for i := 0; i < 64; i++ {
+			if _, err := w.Write(chunk); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient("user", "signer", testPrivateKey).SetApiEndpoint(server.URL)
+	require.Equal(t, DefaultMaxResponseBytes, c.MaxResponseBytes)
+	api := map[string]interface{}{
+		"url":    "/fapi/v1/time",
+		"method": http.MethodGet,
+		"params": map[string]interface{}{},
+	}
+
+	c.MaxResponseBytes = 4096
+	_, err := c.call(context.Background(), api, false)
+	require.ErrorIs(t, err, ErrResponseTooLarge)
+	require.EqualError(t, err, "read response body of GET /fapi/v1/time: response body too large, limit is 4096 bytes")
+
+	c.MaxResponseBytes = 64 * 1024
+	data, err := c.call(context.Background(), api, false)
+	require.NoError(t, err)
+	require.Len(t, data, 64*1024)
+}
+
+func TestRequestID(t *testing.T) {
+	requestIDs := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestIDs <- r.Header.Get(RequestIDHeader)
+		_, _ = w.Write([]byte(`{"serverTime": 1499827319559}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("user", "signer", testPrivateKey).SetApiEndpoint(server.URL)
+	api := map[string]interface{}{
+		"url":    "/fapi/v1/time",
+		"method": http.MethodGet,
+		"params": map[string]interface{}{},
+	}
+	_, err := c.call(ContextWithRequestID(context.Background(), "trace-42"), api, false)
+	require.NoError(t, err)
+	require.Equal(t, "trace-42", <-requestIDs)
+
+	_, err = c.call(context.Background(), api, false)
+	require.NoError(t, err)
+	require.Empty(t, <-requestIDs)
+
+	_, ok := RequestIDFromContext(ContextWithRequestID(context.Background(), ""))
+	require.False(t, ok)
+}
+
+func TestDefaultHeaders(t *testing.T) {
+	headers := make(chan http.Header, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("user", "signer", testPrivateKey,
+		WithUserAgent("my-bot/1.0"),
+		WithDefaultHeaders(map[string]string{"X-Desk": "delta-one", "Content-Type": "text/plain"}),
+	).SetApiEndpoint(server.URL)
+	_, err := c.call(context.Background(), map[string]interface{}{
+		"url":    "/fapi/v1/time",
+		"method": http.MethodGet,
+		"params": map[string]interface{}{},
+	}, false)
+	require.NoError(t, err)
+	h := <-headers
+	require.Equal(t, "my-bot/1.0", h.Get("User-Agent"))
+	require.Equal(t, "delta-one", h.Get("X-Desk"))
+
+	_, err = c.call(context.Background(), map[string]interface{}{
+		"url":    "/fapi/v3/leverage",
+		"method": http.MethodPost,
+		"params": map[string]interface{}{"symbol": "BTCUSDT", "leverage": "10"},
+	}, true)
+	require.NoError(t, err)
+	h = <-headers
+	require.Equal(t, "my-bot/1.0", h.Get("User-Agent"))
+	require.Equal(t, "delta-one", h.Get("X-Desk"))
+	// headers set by the request take precedence
+	require.Equal(t, "application/x-www-form-urlencoded", h.Get("Content-Type"))
+
+	require.Equal(t, "Binance/golang", NewClient("user", "signer", testPrivateKey).UserAgent)
+}
+
+func TestWithDoFunc(t *testing.T) {
+	var req *http.Request
+	c := NewClient("user", "signer", testPrivateKey, WithDoFunc(func(r *http.Request) (*http.Response, error) {
+		req = r
+		return newHTTPResponse([]byte(`{"dualSidePosition": true}`), http.StatusOK), nil
+	}))
+	c.HTTPClient = nil // the do func is used instead of the HTTP client
+
+	mode, err := c.NewGetPositionModeService().Do(context.Background())
+	require.NoError(t, err)
+	require.True(t, mode.DualSidePosition)
+	require.NotNil(t, req)
+	require.Equal(t, http.MethodGet, req.Method)
+	require.Equal(t, "/fapi/v3/positionSide/dual", req.URL.Path)
+	require.Equal(t, "user", req.URL.Query().Get("user"))
+	require.NotEmpty(t, req.URL.Query().Get("signature"))
+}
+
+func TestCallAPIUnifiedPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		do       func(c *Client) error
+		method   string
+		path     string
+		signed   bool
+		params   map[string]string
+		desk     string
+	}{
+		{
+			name:     "unsigned GET",
+			response: `[]`,
+			do: func(c *Client) error {
+				_, err := c.NewKlinesService().Symbol("BTCUSDT").Interval("1m").Limit(2).Do(context.Background())
+				return err
+			},
+			method: http.MethodGet,
+			path:   "/fapi/v1/klines",
+			params: map[string]string{"symbol": "BTCUSDT", "interval": "1m", "limit": "2"},
+		},
+		{
+			name:     "signed GET",
+			response: `{"multiAssetsMargin": true}`,
+			do: func(c *Client) error {
+				_, err := c.NewGetMultiAssetModeService().Do(context.Background(), WithRecvWindow(5000))
+				return err
+			},
+			method: http.MethodGet,
+			path:   "/fapi/v1/multiAssetsMargin",
+			signed: true,
+			params: map[string]string{"recvWindow": "5000"},
+		},
+		{
+			name:     "signed POST",
+			response: `{}`,
+			do: func(c *Client) error {
+				return c.NewUpdatePositionMarginService().Symbol("BTCUSDT").Amount("10").Type(1).Do(context.Background())
+			},
+			method: http.MethodPost,
+			path:   "/fapi/v1/positionMargin",
+			signed: true,
+			params: map[string]string{"symbol": "BTCUSDT", "amount": "10", "type": "1", "recvWindow": "50000"},
+		},
+		{
+			name:     "signed PUT",
+			response: `{}`,
+			do: func(c *Client) error {
+				_, err := c.NewModifyOrderService().Symbol("BTCUSDT").OrderID(1).Side(SideTypeBuy).
+					Quantity("1").Price("100").Do(context.Background())
+				return err
+			},
+			method: http.MethodPut,
+			path:   "/fapi/v1/order",
+			signed: true,
+			params: map[string]string{"symbol": "BTCUSDT", "orderId": "1", "side": "BUY", "quantity": "1", "price": "100"},
+		},
+		{
+			name:     "signed DELETE",
+			response: `{}`,
+			do: func(c *Client) error {
+				return c.NewCancelAllOpenOrdersService().Symbol("BTCUSDT").Do(context.Background(), WithHeader("X-Desk", "delta-one", true))
+			},
+			method: http.MethodDelete,
+			path:   "/fapi/v1/allOpenOrders",
+			signed: true,
+			params: map[string]string{"symbol": "BTCUSDT"},
+			desk:   "delta-one",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req *http.Request
+			c := NewClient("user", "signer", testPrivateKey, WithDoFunc(func(r *http.Request) (*http.Response, error) {
+				req = r
+				return newHTTPResponse([]byte(tt.response), http.StatusOK), nil
+			}))
+			require.NoError(t, tt.do(c))
+			require.NotNil(t, req)
+			require.Equal(t, tt.method, req.Method)
+			require.Equal(t, tt.path, req.URL.Path)
+
+			values := req.URL.Query()
+			if req.Body != nil {
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				values, err = url.ParseQuery(string(body))
+				require.NoError(t, err)
+			}
+			for k, v := range tt.params {
+				require.Equal(t, v, values.Get(k), k)
+			}
+			if tt.signed {
+				require.Equal(t, "user", values.Get("user"))
+				require.Equal(t, "signer", values.Get("signer"))
+				require.NotEmpty(t, values.Get("signature"))
+			} else {
+				require.NotContains(t, values, "signature")
+			}
+			require.Equal(t, tt.desk, req.Header.Get("X-Desk"))
+		})
+	}
+}
+
+func TestCallAPIDryRun(t *testing.T) {
+	c := NewClient("user", "signer", testPrivateKey, WithDryRun())
+	err := c.NewUpdatePositionMarginService().Symbol("BTCUSDT").Amount("10").Type(1).Do(context.Background())
+	dryRun := new(DryRunError)
+	require.ErrorAs(t, err, &dryRun)
+	require.Equal(t, http.MethodPost, dryRun.Method)
+	require.Contains(t, dryRun.Body, "signature=")
+}
+
+func TestRateLimitedError(t *testing.T) {
+	header := http.Header{}
+	statusCode := http.StatusTooManyRequests
+	body := `{"code":-1003,"msg":"Too many requests."}`
+	c := NewClient("user", "signer", testPrivateKey, WithDoFunc(func(r *http.Request) (*http.Response, error) {
+		resp := newHTTPResponse([]byte(body), statusCode)
+		resp.Header = header
+		return resp, nil
+	}))
+
+	header.Set("Retry-After", "2")
+	_, err := c.NewGetPositionModeService().Do(context.Background())
+	require.True(t, common.IsRateLimited(err))
+	require.False(t, common.IsBanned(err))
+	apiErr := new(common.APIError)
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+	require.Equal(t, int64(-1003), apiErr.Code)
+	require.Equal(t, 2*time.Second, apiErr.RetryAfter)
+
+	statusCode = http.StatusTeapot
+	body = `{"code":-1003,"msg":"Way too many requests; IP banned."}`
+	header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	_, err = c.NewGetPositionModeService().Do(context.Background())
+	require.True(t, common.IsBanned(err))
+	require.False(t, common.IsRateLimited(err))
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusTeapot, apiErr.StatusCode)
+	require.InDelta(t, time.Minute, apiErr.RetryAfter, float64(2*time.Second))
+
+	statusCode = http.StatusBadRequest
+	header.Del("Retry-After")
+	_, err = c.NewGetPositionModeService().Do(context.Background())
+	require.False(t, common.IsRateLimited(err))
+	require.False(t, common.IsBanned(err))
+	require.ErrorAs(t, err, &apiErr)
+	require.Zero(t, apiErr.RetryAfter)
+}
+
+func TestConcurrentSignedCalls(t *testing.T) {
+	var mu sync.Mutex
+	nonces := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		nonces[r.PostForm.Get("nonce")]++
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("user", "signer", testPrivateKey).SetApiEndpoint(server.URL)
+	const goroutines, calls = 32, 10
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines*calls)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < calls; j++ {
+				_, err := c.call(context.Background(), map[string]interface{}{
+					"url":    "/fapi/v3/order",
+					"method": http.MethodPost,
+					"params": map[string]interface{}{"symbol": "BTCUSDT"},
+				}, true)
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	// every request was signed with its own nonce
+	require.Len(t, nonces, goroutines*calls)
+}
+
+func TestConnectionReuse(t *testing.T) {
+	var conns atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"serverTime": 1499827319559}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	c := NewClient("user", "signer", testPrivateKey).SetApiEndpoint(server.URL)
+	transport, ok := c.HTTPClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	require.Equal(t, DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	for i := 0; i < 50; i++ {
+		_, err := c.call(context.Background(), map[string]interface{}{
+			"url":    "/fapi/v1/time",
+			"method": http.MethodGet,
+			"params": map[string]interface{}{},
+		}, false)
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(1), conns.Load())
+
+	c = NewClient("user", "signer", testPrivateKey, WithConnectionPool(10, 5, time.Minute))
+	transport = c.HTTPClient.Transport.(*http.Transport)
+	require.Equal(t, 10, transport.MaxIdleConns)
+	require.Equal(t, 5, transport.MaxIdleConnsPerHost)
+	require.Equal(t, time.Minute, transport.IdleConnTimeout)
+}
+
+func newSignTestParams() map[string]interface{} {
+	return map[string]interface{}{
+		"symbol":     "BTCUSDT",
+		"side":       "BUY",
+		"type":       "LIMIT",
+		"quantity":   "0.01",
+		"price":      "60000",
+		"recvWindow": "50000",
+		"timestamp":  "1759212310710",
+	}
+}
+
+func TestSignParams(t *testing.T) {
+	c := NewClient("0x63DD5aCC6b1aa0f563956C0e534DD30B6dcF7C4e", "0x21cF8Ae13Bb72632562c6Fff438652Ba1a151bb0", testPrivateKey)
+	params := newSignTestParams()
+	require.NoError(t, c.signParams(params, 1759212310710000))
+	require.Equal(t, "0x4567b7939ec2eb60a14f12de03ee8ce142b21327a2fefe72fe46daba316ffb6c02fe1e81368ae288ac6b2525c321e0637fb91f91ff8927f29630dec2fb74af8c1c", params["signature"])
+	require.Equal(t, c.User, params["user"])
+	require.Equal(t, c.Signer, params["signer"])
+	require.Equal(t, uint64(1759212310710000), params["nonce"])
+}
+
+func BenchmarkSign(b *testing.B) {
+	c := NewClient("0x63DD5aCC6b1aa0f563956C0e534DD30B6dcF7C4e", "0x21cF8Ae13Bb72632562c6Fff438652Ba1a151bb0", testPrivateKey)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := c.sign(newSignTestParams(), genNonce()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestSignRecoverSigner(t *testing.T) {
+	key, err := crypto.HexToECDSA(testPrivateKey)
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	c := NewClient("0x63DD5aCC6b1aa0f563956C0e534DD30B6dcF7C4e", signer, testPrivateKey)
+
+	params := newSignTestParams()
+	hash, err := c.signHash(params, 1759212310710000)
+	require.NoError(t, err)
+	require.NoError(t, c.signParams(params, 1759212310710000))
+
+	sig, err := hex.DecodeString(strings.TrimPrefix(params["signature"].(string), "0x"))
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	require.NoError(t, err)
+	require.Equal(t, c.Signer, crypto.PubkeyToAddress(*pub).Hex())
+}
+
+func TestBuildSignPayload(t *testing.T) {
+	c := NewClient("0x63DD5aCC6b1aa0f563956C0e534DD30B6dcF7C4e", "0x21cF8Ae13Bb72632562c6Fff438652Ba1a151bb0", testPrivateKey)
+	params := newSignTestParams()
+	payload, err := c.BuildSignPayload(params)
+	require.NoError(t, err)
+	require.Equal(t, `{"price":"60000","quantity":"0.01","recvWindow":"50000","side":"BUY","symbol":"BTCUSDT","timestamp":"1759212310710","type":"LIMIT"}`, payload)
+	require.Equal(t, newSignTestParams(), params)
+
+	// recvWindow and timestamp are added when missing
+	payload, err = c.BuildSignPayload(map[string]interface{}{"symbol": "BTCUSDT"})
+	require.NoError(t, err)
+	require.Regexp(t, `^\{"recvWindow":"50000","symbol":"BTCUSDT","timestamp":"\d{13}"\}$`, payload)
+}
+
+func TestWithClock(t *testing.T) {
+	clock := time.UnixMilli(1759212310710)
+	signedQuery := func() url.Values {
+		var query url.Values
+		c := NewClient("0x63DD5aCC6b1aa0f563956C0e534DD30B6dcF7C4e", "0x21cF8Ae13Bb72632562c6Fff438652Ba1a151bb0", testPrivateKey,
+			WithClock(func() time.Time { return clock }),
+			WithDoFunc(func(r *http.Request) (*http.Response, error) {
+				query = r.URL.Query()
+				return newHTTPResponse([]byte(`{"dualSidePosition": false}`), http.StatusOK), nil
+			}))
+		_, err := c.NewGetPositionModeService().Do(context.Background())
+		require.NoError(t, err)
+		return query
+	}
+
+	query := signedQuery()
+	require.Equal(t, "1759212310710", query.Get("timestamp"))
+	require.Equal(t, "1759212310710000", query.Get("nonce"))
+	require.Equal(t, "0x16a7654b9659c6c9e2aa4ab689b23e1c6e21cfbcf7b0a8a117c3800e9a5d1c3e162cbfe29ccce8a28b34b04457277e6f3865aa4221855e54592a6bae442858141c", query.Get("signature"))
+	require.Equal(t, query, signedQuery())
+}
+
+func TestSignHashPersonalSign(t *testing.T) {
+	// EIP-191 version 0x45: keccak256("\x19Ethereum Signed Message:\n" + len(message) + message)
+	payload := crypto.Keccak256([]byte("payload"))
+	message := fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(payload), payload)
+	require.Equal(t, crypto.Keccak256([]byte(message)), crypto.Keccak256Hash(ethSignedHashPrefix, payload).Bytes())
+}
+
+func TestStrictJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"feeTier": 1, "canTrade": true, "newField": "x"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("user", "signer", testPrivateKey).SetApiEndpoint(server.URL)
+	account, err := c.NewGetAccountService().Do(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, account.FeeTier)
+
+	c.StrictJSON = true
+	_, err = c.NewGetAccountService().Do(context.Background())
+	require.EqualError(t, err, `json: unknown field "newField"`)
+}
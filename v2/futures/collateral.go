@@ -0,0 +1,53 @@
+package futures
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// CollateralValue return the multi-assets mode collateral value of the account in USD.
+// Each asset margin balance is valued at its asset index bidRate when positive and askRate when negative.
+func (c *Client) CollateralValue(ctx context.Context) (decimal.Decimal, error) {
+	account, err := c.NewGetAccountService().Do(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	indexes, err := c.NewAssetIndexService().Do(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return collateralValue(account.Assets, indexes)
+}
+
+func collateralValue(assets []*AccountAsset, indexes []*AssetIndex) (decimal.Decimal, error) {
+	bySymbol := make(map[string]*AssetIndex, len(indexes))
+	for _, index := range indexes {
+		bySymbol[index.Symbol] = index
+	}
+	total := decimal.Zero
+	for _, asset := range assets {
+		balance, err := parseDecimal(asset.Asset+" marginBalance", asset.MarginBalance)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		if balance.IsZero() {
+			continue
+		}
+		index, ok := bySymbol[asset.Asset+"USD"]
+		if !ok {
+			return decimal.Zero, fmt.Errorf("no asset index for %s", asset.Asset)
+		}
+		name, rate := "bidRate", index.BidRate
+		if balance.IsNegative() {
+			name, rate = "askRate", index.AskRate
+		}
+		r, err := parseDecimal(index.Symbol+" "+name, rate)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		total = total.Add(balance.Mul(r))
+	}
+	return total, nil
+}
@@ -0,0 +1,42 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type collateralTestSuite struct {
+	baseTestSuite
+}
+
+func TestCollateral(t *testing.T) {
+	suite.Run(t, new(collateralTestSuite))
+}
+
+var collateralAssetIndexData = []byte(`[
+	{"symbol": "BTCUSD", "index": "60000", "bidBuffer": "0.05", "askBuffer": "0.05", "bidRate": "57000", "askRate": "63000"},
+	{"symbol": "USDTUSD", "index": "1", "bidBuffer": "0.0001", "askBuffer": "0.0001", "bidRate": "0.9999", "askRate": "1.0001"}
+]`)
+
+func (s *collateralTestSuite) TestCollateralValue() {
+	s.mockDoOnce([]byte(`{"multiAssetsMargin": true, "assets": [
+		{"asset": "BTC", "marginBalance": "0.5"},
+		{"asset": "USDT", "marginBalance": "-1000"},
+		{"asset": "BNB", "marginBalance": "0"}
+	]}`), nil)
+	s.mockDoOnce(collateralAssetIndexData, nil)
+
+	value, err := s.client.CollateralValue(newContext())
+	s.r().NoError(err)
+	// 0.5 * 57000 - 1000 * 1.0001
+	s.r().Equal("27499.9", value.String())
+}
+
+func (s *collateralTestSuite) TestCollateralValueMissingIndex() {
+	s.mockDoOnce([]byte(`{"assets": [{"asset": "ETH", "marginBalance": "1"}]}`), nil)
+	s.mockDoOnce(collateralAssetIndexData, nil)
+
+	_, err := s.client.CollateralValue(newContext())
+	s.r().EqualError(err, "no asset index for ETH")
+}
@@ -0,0 +1,287 @@
+package futures
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/jpillora/backoff"
+)
+
+var (
+	// CombinedStreamReconnectMinInterval is the delay before the first reconnection attempt of a WsCombinedStream
+	CombinedStreamReconnectMinInterval = time.Second
+	// CombinedStreamReconnectMaxInterval caps the delay between the reconnection attempts of a WsCombinedStream
+	CombinedStreamReconnectMaxInterval = 30 * time.Second
+)
+
+// WsCombinedStreamHandler handle the data of a message received on a combined stream
+type WsCombinedStreamHandler func(stream string, data []byte)
+
+// WsCombinedStream is a combined stream connection on which streams can be subscribed and unsubscribed
+// while it is running. Subscriptions are reference counted, a stream is subscribed once whatever the number
+// of Subscribe calls and unsubscribed when the last subscriber is gone.
+// When the connection drops, the error is passed to the error handler and the stream reconnects with backoff
+// until it is closed, the active streams are subscribed again on the new connection.
+type WsCombinedStream struct {
+	cfg        *WsConfig
+	rawHandler WsHandler
+	handler    WsCombinedStreamHandler
+	errHandler ErrHandler
+	stats      *StreamStats
+	reconnects atomic.Uint64
+
+	mu            sync.Mutex
+	conn          *websocket.Conn
+	subscriptions map[string]int
+	lastID        atomic.Int64
+	closed        atomic.Bool
+	closeC        chan struct{}
+	doneC         chan struct{}
+}
+
+type wsCombinedStreamRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params"`
+	ID     int64    `json:"id"`
+}
+
+type wsCombinedStreamMessage struct {
+	Stream string          `json:"stream"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// NewWsCombinedStream connect to the combined stream endpoint, no stream is subscribed until Subscribe is called
+func NewWsCombinedStream(handler WsCombinedStreamHandler, errHandler ErrHandler, opts ...WsOption) (*WsCombinedStream, error) {
+	o := newWsOptions(opts)
+	endpoint := strings.TrimSuffix(o.baseCombinedEndpoint(), "?streams=")
+	cfg := o.config(endpoint)
+	if cfg.Stats == nil {
+		cfg.Stats = new(StreamStats)
+	}
+	conn, err := dialCombinedStream(cfg)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Stats.start()
+	s := &WsCombinedStream{
+		cfg:           cfg,
+		conn:          conn,
+		rawHandler:    cfg.RawHandler,
+		handler:       handler,
+		errHandler:    errHandler,
+		stats:         cfg.Stats,
+		subscriptions: make(map[string]int),
+		closeC:        make(chan struct{}),
+		doneC:         make(chan struct{}),
+	}
+	go s.read()
+	return s, nil
+}
+
+func dialCombinedStream(cfg *WsConfig) (*websocket.Conn, error) {
+	conn, err := WsGetReadWriteConnection(cfg)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetReadLimit(655350)
+	if WebsocketKeepalive {
+		keepAlive(conn, WebsocketTimeout)
+	}
+	return conn, nil
+}
+
+func (s *WsCombinedStream) read() {
+	defer close(s.doneC)
+	s.mu.Lock()
+	conn := s.conn
+	s.mu.Unlock()
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			if s.closed.Load() {
+				return
+			}
+			s.errHandler(err)
+			if conn = s.reconnect(); conn == nil {
+				return
+			}
+			continue
+		}
+		if s.rawHandler != nil {
+			s.rawHandler(message)
+		}
+		msg := new(wsCombinedStreamMessage)
+		if err := json.Unmarshal(message, msg); err != nil {
+			s.errHandler(err)
+			continue
+		}
+		// responses to SUBSCRIBE and UNSUBSCRIBE have no stream
+		if msg.Stream == "" {
+			continue
+		}
+		s.stats.onMessage()
+		s.handler(msg.Stream, msg.Data)
+	}
+}
+
+// reconnect dial a new connection with backoff and subscribe the active streams on it,
+// it returns nil once the stream is closed
+func (s *WsCombinedStream) reconnect() *websocket.Conn {
+	b := &backoff.Backoff{
+		Min:    CombinedStreamReconnectMinInterval,
+		Max:    CombinedStreamReconnectMaxInterval,
+		Factor: 2,
+	}
+	for {
+		select {
+		case <-s.closeC:
+			return nil
+		case <-time.After(b.Duration()):
+		}
+		conn, err := dialCombinedStream(s.cfg)
+		if err != nil {
+			s.errHandler(err)
+			continue
+		}
+		s.mu.Lock()
+		if s.closed.Load() {
+			s.mu.Unlock()
+			conn.Close()
+			return nil
+		}
+		s.conn = conn
+		streams := make([]string, 0, len(s.subscriptions))
+		for stream := range s.subscriptions {
+			streams = append(streams, stream)
+		}
+		sort.Strings(streams)
+		if len(streams) > 0 {
+			err = s.send("SUBSCRIBE", streams)
+		}
+		s.mu.Unlock()
+		if err != nil {
+			conn.Close()
+			s.errHandler(err)
+			continue
+		}
+		s.reconnects.Add(1)
+		return conn
+	}
+}
+
+// Subscribe add a subscriber to each stream, only the streams without subscriber yet are sent to the server
+func (s *WsCombinedStream) Subscribe(streams ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	streams = dedupStreams(streams)
+	var added []string
+	for _, stream := range streams {
+		if s.subscriptions[stream] == 0 {
+			added = append(added, stream)
+		}
+	}
+	if len(added) > 0 {
+		if err := s.send("SUBSCRIBE", added); err != nil {
+			return err
+		}
+	}
+	for _, stream := range streams {
+		s.subscriptions[stream]++
+	}
+	return nil
+}
+
+// Unsubscribe remove a subscriber from each stream, the streams left without subscriber are unsubscribed from the server
+func (s *WsCombinedStream) Unsubscribe(streams ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	streams = dedupStreams(streams)
+	var removed []string
+	for _, stream := range streams {
+		if s.subscriptions[stream] == 1 {
+			removed = append(removed, stream)
+		}
+	}
+	if len(removed) > 0 {
+		if err := s.send("UNSUBSCRIBE", removed); err != nil {
+			return err
+		}
+	}
+	for _, stream := range streams {
+		switch n := s.subscriptions[stream]; {
+		case n > 1:
+			s.subscriptions[stream]--
+		case n == 1:
+			delete(s.subscriptions, stream)
+		}
+	}
+	return nil
+}
+
+// ActiveStreams return the sorted list of the streams currently subscribed
+func (s *WsCombinedStream) ActiveStreams() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	streams := make([]string, 0, len(s.subscriptions))
+	for stream := range s.subscriptions {
+		streams = append(streams, stream)
+	}
+	sort.Strings(streams)
+	return streams
+}
+
+// Stats return the health counters of the stream, the ones passed WithWsStats if any
+func (s *WsCombinedStream) Stats() *StreamStats {
+	return s.stats
+}
+
+// Reconnects return the number of times the stream reconnected after its connection dropped
+func (s *WsCombinedStream) Reconnects() uint64 {
+	return s.reconnects.Load()
+}
+
+// Done return a channel closed once the stream is closed
+func (s *WsCombinedStream) Done() <-chan struct{} {
+	return s.doneC
+}
+
+// Close close the connection without calling the error handler
+func (s *WsCombinedStream) Close() error {
+	if s.closed.Swap(true) {
+		return nil
+	}
+	close(s.closeC)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.Close()
+}
+
+// send must be called with mu held, the connection supports a single concurrent writer
+func (s *WsCombinedStream) send(method string, streams []string) error {
+	if s.closed.Load() {
+		return errors.New("combined stream is closed")
+	}
+	return s.conn.WriteJSON(&wsCombinedStreamRequest{
+		Method: method,
+		Params: streams,
+		ID:     s.lastID.Add(1),
+	})
+}
+
+func dedupStreams(streams []string) []string {
+	seen := make(map[string]bool, len(streams))
+	res := make([]string, 0, len(streams))
+	for _, stream := range streams {
+		if !seen[stream] {
+			seen[stream] = true
+			res = append(res, stream)
+		}
+	}
+	return res
+}
@@ -0,0 +1,185 @@
+package futures
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// newCombinedStreamTestServer record the control messages received and push the given payloads after each one,
+// the returned option connects a stream to it
+func newCombinedStreamTestServer(t *testing.T, requests chan<- wsCombinedStreamRequest, push [][]byte) WsOption {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		for {
+			req := wsCombinedStreamRequest{}
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			requests <- req
+			if err := conn.WriteJSON(map[string]interface{}{"result": nil, "id": req.ID}); err != nil {
+				return
+			}
+			for _, message := range push {
+				if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return WithWsCombinedEndpoint("ws" + strings.TrimPrefix(server.URL, "http") + "/stream?streams=")
+}
+
+func receiveCombinedStreamRequest(t *testing.T, requests <-chan wsCombinedStreamRequest) wsCombinedStreamRequest {
+	select {
+	case req := <-requests:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("control message not received")
+	}
+	return wsCombinedStreamRequest{}
+}
+
+func TestWsCombinedStreamSubscribeTwice(t *testing.T) {
+	requests := make(chan wsCombinedStreamRequest, 10)
+	endpoint := newCombinedStreamTestServer(t, requests, [][]byte{
+		[]byte(`{"stream":"btcusdt@aggTrade","data":{"e":"aggTrade","s":"BTCUSDT"}}`),
+	})
+
+	type message struct {
+		stream string
+		data   string
+	}
+	messages := make(chan message, 10)
+	stream, err := NewWsCombinedStream(func(stream string, data []byte) {
+		messages <- message{stream, string(data)}
+	}, func(err error) {
+		t.Error(err)
+	}, endpoint)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	require.NoError(t, stream.Subscribe("btcusdt@aggTrade"))
+	require.NoError(t, stream.Subscribe("btcusdt@aggTrade", "btcusdt@aggTrade"))
+	require.Equal(t, []string{"btcusdt@aggTrade"}, stream.ActiveStreams())
+
+	req := receiveCombinedStreamRequest(t, requests)
+	require.Equal(t, wsCombinedStreamRequest{Method: "SUBSCRIBE", Params: []string{"btcusdt@aggTrade"}, ID: 1}, req)
+	select {
+	case m := <-messages:
+		require.Equal(t, "btcusdt@aggTrade", m.stream)
+		require.JSONEq(t, `{"e":"aggTrade","s":"BTCUSDT"}`, m.data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message not received")
+	}
+
+	// the second subscriber keep the stream subscribed
+	require.NoError(t, stream.Unsubscribe("btcusdt@aggTrade"))
+	require.Equal(t, []string{"btcusdt@aggTrade"}, stream.ActiveStreams())
+	require.NoError(t, stream.Subscribe("ethusdt@markPrice"))
+	req = receiveCombinedStreamRequest(t, requests)
+	require.Equal(t, wsCombinedStreamRequest{Method: "SUBSCRIBE", Params: []string{"ethusdt@markPrice"}, ID: 2}, req)
+
+	require.NoError(t, stream.Unsubscribe("btcusdt@aggTrade"))
+	req = receiveCombinedStreamRequest(t, requests)
+	require.Equal(t, wsCombinedStreamRequest{Method: "UNSUBSCRIBE", Params: []string{"btcusdt@aggTrade"}, ID: 3}, req)
+	require.Equal(t, []string{"ethusdt@markPrice"}, stream.ActiveStreams())
+
+	// unsubscribing an unknown stream send nothing
+	require.NoError(t, stream.Unsubscribe("btcusdt@aggTrade"))
+	require.Empty(t, requests)
+	require.NotZero(t, stream.Stats().Messages())
+
+	require.NoError(t, stream.Close())
+	select {
+	case <-stream.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream not closed")
+	}
+	require.EqualError(t, stream.Subscribe("bnbusdt@aggTrade"), "combined stream is closed")
+}
+
+func TestWsCombinedStreamRequestJSON(t *testing.T) {
+	data, err := json.Marshal(&wsCombinedStreamRequest{Method: "SUBSCRIBE", Params: []string{"btcusdt@depth"}, ID: 7})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"method":"SUBSCRIBE","params":["btcusdt@depth"],"id":7}`, string(data))
+}
+
+func TestWsCombinedStreamResubscribeOnReconnect(t *testing.T) {
+	minInterval := CombinedStreamReconnectMinInterval
+	CombinedStreamReconnectMinInterval = time.Millisecond
+	defer func() { CombinedStreamReconnectMinInterval = minInterval }()
+
+	requests := make(chan wsCombinedStreamRequest, 10)
+	conns := make(chan *websocket.Conn, 2)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		conns <- conn
+		for {
+			req := wsCombinedStreamRequest{}
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			requests <- req
+		}
+	}))
+	defer server.Close()
+
+	errs := make(chan error, 10)
+	stream, err := NewWsCombinedStream(func(stream string, data []byte) {}, func(err error) {
+		errs <- err
+	}, WithWsCombinedEndpoint("ws"+strings.TrimPrefix(server.URL, "http")+"/stream?streams="))
+	require.NoError(t, err)
+	defer stream.Close()
+
+	require.NoError(t, stream.Subscribe("btcusdt@aggTrade", "ethusdt@markPrice"))
+	require.NoError(t, stream.Subscribe("bnbusdt@depth"))
+	require.NoError(t, stream.Unsubscribe("ethusdt@markPrice"))
+	for i := 0; i < 3; i++ {
+		receiveCombinedStreamRequest(t, requests)
+	}
+
+	// drop the first connection
+	(<-conns).Close()
+	req := receiveCombinedStreamRequest(t, requests)
+	require.Equal(t, wsCombinedStreamRequest{
+		Method: "SUBSCRIBE",
+		Params: []string{"bnbusdt@depth", "btcusdt@aggTrade"},
+		ID:     4,
+	}, req)
+	require.NotEmpty(t, errs)
+	require.Eventually(t, func() bool {
+		return stream.Reconnects() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// new subscriptions go to the new connection
+	require.NoError(t, stream.Subscribe("xrpusdt@aggTrade"))
+	req = receiveCombinedStreamRequest(t, requests)
+	require.Equal(t, wsCombinedStreamRequest{Method: "SUBSCRIBE", Params: []string{"xrpusdt@aggTrade"}, ID: 5}, req)
+
+	require.NoError(t, stream.Close())
+	select {
+	case <-stream.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream not closed")
+	}
+}
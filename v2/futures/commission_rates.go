@@ -0,0 +1,65 @@
+package futures
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type cachedCommissionRate struct {
+	maker     decimal.Decimal
+	taker     decimal.Decimal
+	updatedAt time.Time
+}
+
+// CommissionRates cache maker/taker commission rates per symbol and refresh them after ttl
+type CommissionRates struct {
+	c     *Client
+	ttl   time.Duration
+	mu    sync.Mutex
+	rates map[string]cachedCommissionRate
+}
+
+// NewCommissionRates init a commission rate cache, the rate of a symbol is loaded on its first lookup
+func (c *Client) NewCommissionRates(ttl time.Duration) *CommissionRates {
+	return &CommissionRates{c: c, ttl: ttl, rates: make(map[string]cachedCommissionRate)}
+}
+
+// Rate return the cached maker or taker commission rate of symbol, refreshing it when it is expired
+func (r *CommissionRates) Rate(ctx context.Context, symbol string, isMaker bool) (decimal.Decimal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rate, ok := r.rates[symbol]
+	if !ok || time.Since(rate.updatedAt) >= r.ttl {
+		res, err := r.c.NewCommissionRateService().Symbol(symbol).Do(ctx)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		rate.maker, err = parseDecimal("makerCommissionRate", res.MakerCommissionRate)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		rate.taker, err = parseDecimal("takerCommissionRate", res.TakerCommissionRate)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		rate.updatedAt = time.Now()
+		r.rates[symbol] = rate
+	}
+	if isMaker {
+		return rate.maker, nil
+	}
+	return rate.taker, nil
+}
+
+// EstimateFee return the commission paid for trading notional of symbol as maker or taker,
+// a negative fee is a rebate
+func (r *CommissionRates) EstimateFee(ctx context.Context, symbol string, notional decimal.Decimal, isMaker bool) (decimal.Decimal, error) {
+	rate, err := r.Rate(ctx, symbol, isMaker)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return notional.Abs().Mul(rate), nil
+}
@@ -0,0 +1,64 @@
+package futures
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type commissionRatesTestSuite struct {
+	baseTestSuite
+}
+
+func TestCommissionRates(t *testing.T) {
+	suite.Run(t, new(commissionRatesTestSuite))
+}
+
+func (s *commissionRatesTestSuite) TestEstimateFee() {
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "makerCommissionRate": "0.0002", "takerCommissionRate": "0.0004"}`), nil)
+	rates := s.client.NewCommissionRates(time.Hour)
+
+	fee, err := rates.EstimateFee(newContext(), "BTCUSDT", decimal.NewFromInt(10000), true)
+	s.r().NoError(err)
+	s.r().Equal("2", fee.String())
+	fee, err = rates.EstimateFee(newContext(), "BTCUSDT", decimal.NewFromInt(-10000), false)
+	s.r().NoError(err)
+	s.r().Equal("4", fee.String())
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *commissionRatesTestSuite) TestExpired() {
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "makerCommissionRate": "0.0002", "takerCommissionRate": "0.0004"}`), nil)
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "makerCommissionRate": "-0.0001", "takerCommissionRate": "0.0003"}`), nil)
+	rates := s.client.NewCommissionRates(0)
+
+	_, err := rates.Rate(newContext(), "BTCUSDT", true)
+	s.r().NoError(err)
+	rate, err := rates.Rate(newContext(), "BTCUSDT", true)
+	s.r().NoError(err)
+	s.r().Equal("-0.0001", rate.String())
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *commissionRatesTestSuite) TestInvalidRate() {
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "makerCommissionRate": "", "takerCommissionRate": "0.0004"}`), nil)
+	rates := s.client.NewCommissionRates(time.Hour)
+
+	_, err := rates.EstimateFee(newContext(), "BTCUSDT", decimal.NewFromInt(1), false)
+	s.r().ErrorContains(err, `invalid makerCommissionRate ""`)
+}
+
+func (s *commissionRatesTestSuite) TestContext() {
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "makerCommissionRate": "0.0002", "takerCommissionRate": "0.0004"}`), nil)
+	rates := s.client.NewCommissionRates(time.Hour)
+	ctx, cancel := context.WithCancel(newContext())
+	cancel()
+
+	_, _ = rates.Rate(ctx, "BTCUSDT", true)
+	req := s.client.Calls[0].Arguments.Get(0).(*http.Request)
+	s.r().ErrorIs(req.Context().Err(), context.Canceled)
+}
@@ -0,0 +1,107 @@
+package futures
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ConstituentsChange describe a change of the composition of an index between two polls of ConstituentsWatcher
+type ConstituentsChange struct {
+	Symbol       string
+	Time         uint64
+	Added        []*Constituents
+	Removed      []*Constituents
+	Constituents []*Constituents
+}
+
+// ConstituentsWatcher poll the constituents of an index every ttl and call the handler when the composition changes.
+// There is no stream of the constituents, the composite index stream only carries the weights of the base assets.
+type ConstituentsWatcher struct {
+	c          *Client
+	symbol     string
+	ttl        time.Duration
+	handler    func(change *ConstituentsChange)
+	errHandler ErrHandler
+
+	mu           sync.Mutex
+	constituents []*Constituents
+	polled       bool
+}
+
+// NewConstituentsWatcher init a watcher of the constituents of the index symbol, errors of the polls
+// made by Run are passed to errHandler
+func (c *Client) NewConstituentsWatcher(symbol string, ttl time.Duration, handler func(change *ConstituentsChange), errHandler ErrHandler) *ConstituentsWatcher {
+	return &ConstituentsWatcher{c: c, symbol: symbol, ttl: ttl, handler: handler, errHandler: errHandler}
+}
+
+// Run poll the constituents until ctx is done, it returns ctx.Err()
+func (w *ConstituentsWatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.ttl)
+	defer ticker.Stop()
+	for {
+		if _, err := w.Poll(ctx); err != nil && ctx.Err() == nil && w.errHandler != nil {
+			w.errHandler(err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// Poll refresh the constituents and return the change, nil on the first poll or when the composition is unchanged.
+// The handler is called with the change before Poll returns.
+func (w *ConstituentsWatcher) Poll(ctx context.Context) (*ConstituentsChange, error) {
+	res, err := w.c.NewConstituentsService().Symbol(w.symbol).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	w.mu.Lock()
+	previous, polled := w.constituents, w.polled
+	w.constituents, w.polled = res.Constituents, true
+	w.mu.Unlock()
+	if !polled {
+		return nil, nil
+	}
+	change := &ConstituentsChange{
+		Symbol:       res.Symbol,
+		Time:         res.Time,
+		Added:        diffConstituents(res.Constituents, previous),
+		Removed:      diffConstituents(previous, res.Constituents),
+		Constituents: res.Constituents,
+	}
+	if len(change.Added) == 0 && len(change.Removed) == 0 {
+		return nil, nil
+	}
+	if w.handler != nil {
+		w.handler(change)
+	}
+	return change, nil
+}
+
+// Constituents return the constituents of the last poll
+func (w *ConstituentsWatcher) Constituents() []*Constituents {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.constituents
+}
+
+// diffConstituents return the constituents of a missing from b
+func diffConstituents(a, b []*Constituents) []*Constituents {
+	type key struct{ exchange, symbol string }
+	inB := make(map[key]bool, len(b))
+	for _, c := range b {
+		inB[key{c.Exchange, c.Symbol}] = true
+	}
+	diff := make([]*Constituents, 0)
+	for _, c := range a {
+		if !inB[key{c.Exchange, c.Symbol}] {
+			diff = append(diff, c)
+		}
+	}
+	return diff
+}
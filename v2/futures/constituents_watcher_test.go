@@ -0,0 +1,83 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type constituentsWatcherTestSuite struct {
+	baseTestSuite
+}
+
+func TestConstituentsWatcher(t *testing.T) {
+	suite.Run(t, new(constituentsWatcherTestSuite))
+}
+
+var (
+	constituentsBefore = []byte(`{"symbol": "BTCUSDT", "time": 1719554596473, "constituents": [
+		{"exchange": "binance", "symbol": "BTCUSDT"},
+		{"exchange": "okex", "symbol": "BTC-USDT"},
+		{"exchange": "huobi", "symbol": "btcusdt"}
+	]}`)
+	constituentsAfter = []byte(`{"symbol": "BTCUSDT", "time": 1719554696473, "constituents": [
+		{"exchange": "binance", "symbol": "BTCUSDT"},
+		{"exchange": "okex", "symbol": "BTC-USDT"},
+		{"exchange": "coinbase", "symbol": "BTC-USD"}
+	]}`)
+)
+
+func (s *constituentsWatcherTestSuite) TestPoll() {
+	s.mockDoOnce(constituentsBefore, nil)
+	s.mockDoOnce(constituentsBefore, nil)
+	s.mockDoOnce(constituentsAfter, nil)
+
+	var changes []*ConstituentsChange
+	w := s.client.NewConstituentsWatcher("BTCUSDT", time.Minute, func(change *ConstituentsChange) {
+		changes = append(changes, change)
+	}, nil)
+	r := s.r()
+	change, err := w.Poll(newContext())
+	r.NoError(err)
+	r.Nil(change)
+	r.Len(w.Constituents(), 3)
+
+	change, err = w.Poll(newContext())
+	r.NoError(err)
+	r.Nil(change)
+
+	change, err = w.Poll(newContext())
+	r.NoError(err)
+	r.NotNil(change)
+	r.Equal([]*ConstituentsChange{change}, changes)
+	r.Equal("BTCUSDT", change.Symbol)
+	r.Equal(uint64(1719554696473), change.Time)
+	r.Equal([]*Constituents{{Exchange: "coinbase", Symbol: "BTC-USD"}}, change.Added)
+	r.Equal([]*Constituents{{Exchange: "huobi", Symbol: "btcusdt"}}, change.Removed)
+	r.Len(change.Constituents, 3)
+	r.Equal(change.Constituents, w.Constituents())
+}
+
+func (s *constituentsWatcherTestSuite) TestRun() {
+	s.mockDoOnce(nil, errors.New("dummy err"))
+	s.mockDoOnce(constituentsBefore, nil)
+	s.mockDoOnce(constituentsAfter, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changeC := make(chan *ConstituentsChange, 1)
+	errC := make(chan error, 1)
+	w := s.client.NewConstituentsWatcher("BTCUSDT", time.Millisecond, func(change *ConstituentsChange) {
+		changeC <- change
+		cancel()
+	}, func(err error) {
+		errC <- err
+	})
+	s.r().ErrorIs(w.Run(ctx), context.Canceled)
+	s.r().EqualError(<-errC, "dummy err")
+	change := <-changeC
+	s.r().Equal([]*Constituents{{Exchange: "coinbase", Symbol: "BTC-USD"}}, change.Added)
+}
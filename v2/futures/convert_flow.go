@@ -0,0 +1,140 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coin-quant/go-aster/v2/common"
+)
+
+var (
+	// ConvertFlowPollInterval is the delay between the status queries of ConvertFlow
+	ConvertFlowPollInterval = 500 * time.Millisecond
+	// ConvertFlowTimeout is the default time ConvertFlow wait for the convert order to be terminal
+	ConvertFlowTimeout = 30 * time.Second
+)
+
+var (
+	// ErrConvertQuoteExpired is returned by ConvertFlow when the exchange rejected the quote after it expired
+	ErrConvertQuoteExpired = errors.New("convert quote expired")
+	// ErrConvertFailed is returned by ConvertFlow when the convert order ends in FAILED status
+	ErrConvertFailed = errors.New("convert failed")
+	// ErrConvertTimeout is returned by ConvertFlow when the convert order is still processing at the deadline
+	ErrConvertTimeout = errors.New("convert order not terminal before deadline")
+)
+
+// ConvertFlow convert fromAmount of fromAsset to toAsset: it gets a quote, accepts it
+// and polls the order status until it is SUCCESS or FAILED
+type ConvertFlow struct {
+	c          *Client
+	fromAsset  string
+	toAsset    string
+	fromAmount string
+	validTime  ConvertValidTime
+	timeout    time.Duration
+}
+
+// NewConvertFlow init a convert of fromAmount of fromAsset to toAsset
+func (c *Client) NewConvertFlow(fromAsset, toAsset, fromAmount string) *ConvertFlow {
+	return &ConvertFlow{c: c, fromAsset: fromAsset, toAsset: toAsset, fromAmount: fromAmount, timeout: ConvertFlowTimeout}
+}
+
+// ValidTime set the validity of the quote, 10s by default
+func (f *ConvertFlow) ValidTime(validTime ConvertValidTime) *ConvertFlow {
+	f.validTime = validTime
+	return f
+}
+
+// Timeout set the time waited for the order to be terminal once the quote is accepted
+func (f *ConvertFlow) Timeout(timeout time.Duration) *ConvertFlow {
+	f.timeout = timeout
+	return f
+}
+
+// Do run the flow and return the final status of the convert order.
+// ErrConvertQuoteExpired, ErrConvertFailed or ErrConvertTimeout are wrapped in the error of the matching outcome,
+// the last known status is returned along ErrConvertFailed and ErrConvertTimeout.
+// The accept request is not cancelled when the quote expires, the exchange reject expired quotes. When it fails
+// without a response, e.g. on a transport error or when ctx is done, the convert order of the quote is queried
+// before an outcome is reported, as the quote may have been accepted.
+func (f *ConvertFlow) Do(ctx context.Context, opts ...RequestOption) (*ConvertStatusResult, error) {
+	quoteService := f.c.NewCreateConvertQuoteService().FromAsset(f.fromAsset).ToAsset(f.toAsset).FromAmount(f.fromAmount)
+	if f.validTime != "" {
+		quoteService.ValidTime(f.validTime)
+	}
+	quote, err := quoteService.Do(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("get convert quote: %w", err)
+	}
+	result, err := f.c.NewConvertAcceptService().QuoteId(quote.QuoteId).Do(ctx, opts...)
+	if err != nil {
+		return f.recoverAccept(ctx, quote, err, opts...)
+	}
+	if result.OrderStatus == ConvertAcceptStatusFailed {
+		return nil, fmt.Errorf("%w: order %s", ErrConvertFailed, result.OrderId)
+	}
+	return f.wait(ctx, quote.QuoteId, result.OrderId, opts...)
+}
+
+// recoverAccept report the outcome of quote whose accept request failed with acceptErr
+func (f *ConvertFlow) recoverAccept(ctx context.Context, quote *ConvertQuote, acceptErr error, opts ...RequestOption) (*ConvertStatusResult, error) {
+	var apiErr *common.APIError
+	if errors.As(acceptErr, &apiErr) {
+		if quote.ValidTimestamp > 0 && !time.Now().Before(time.UnixMilli(quote.ValidTimestamp)) {
+			return nil, fmt.Errorf("%w: accept quote %s: %w", ErrConvertQuoteExpired, quote.QuoteId, acceptErr)
+		}
+		return nil, fmt.Errorf("accept convert quote %s: %w", quote.QuoteId, acceptErr)
+	}
+	// the exchange may have accepted the quote, query its order even when ctx is done
+	queryCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), f.timeout)
+	status, err := f.c.NewGetConvertStatusService().QuoteId(quote.QuoteId).Do(queryCtx, opts...)
+	cancel()
+	switch {
+	case errors.As(err, &apiErr):
+		return nil, fmt.Errorf("accept convert quote %s: %w", quote.QuoteId, acceptErr)
+	case err != nil:
+		return nil, fmt.Errorf("accept convert quote %s: %w, the order status is unknown: %w", quote.QuoteId, acceptErr, err)
+	}
+	if terminal, err := convertOutcome(status); terminal || ctx.Err() != nil {
+		if !terminal {
+			err = fmt.Errorf("accept convert quote %s: order %s is %s: %w", quote.QuoteId, status.OrderId, status.OrderStatus, ctx.Err())
+		}
+		return status, err
+	}
+	return f.wait(ctx, quote.QuoteId, status.OrderId, opts...)
+}
+
+// convertOutcome return whether a convert order is terminal, with ErrConvertFailed when it failed
+func convertOutcome(status *ConvertStatusResult) (terminal bool, err error) {
+	switch status.OrderStatus {
+	case ConvertAcceptStatusSuccess:
+		return true, nil
+	case ConvertAcceptStatusFailed:
+		return true, fmt.Errorf("%w: order %s", ErrConvertFailed, status.OrderId)
+	}
+	return false, nil
+}
+
+// wait poll the status of the convert order until it is terminal or the timeout elapses
+func (f *ConvertFlow) wait(ctx context.Context, quoteID, orderID string, opts ...RequestOption) (*ConvertStatusResult, error) {
+	deadline := time.NewTimer(f.timeout)
+	defer deadline.Stop()
+	for {
+		status, err := f.c.NewGetConvertStatusService().QuoteId(quoteID).OrderId(orderID).Do(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("get convert order %s status: %w", orderID, err)
+		}
+		if terminal, err := convertOutcome(status); terminal {
+			return status, err
+		}
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-deadline.C:
+			return status, fmt.Errorf("%w: order %s is %s after %s", ErrConvertTimeout, orderID, status.OrderStatus, f.timeout)
+		case <-time.After(ConvertFlowPollInterval):
+		}
+	}
+}
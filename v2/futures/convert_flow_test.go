@@ -0,0 +1,146 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/coin-quant/go-aster/v2/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type convertFlowTestSuite struct {
+	baseTestSuite
+}
+
+func TestConvertFlow(t *testing.T) {
+	suite.Run(t, new(convertFlowTestSuite))
+}
+
+func (s *convertFlowTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	interval := ConvertFlowPollInterval
+	ConvertFlowPollInterval = time.Millisecond
+	s.T().Cleanup(func() {
+		ConvertFlowPollInterval = interval
+	})
+}
+
+func convertQuoteData(validUntil time.Time) []byte {
+	return []byte(fmt.Sprintf(`{"quoteId": "12415572564", "ratio": "38163.7", "inverseRatio": "0.0000262",
+		"validTimestamp": %d, "toAmount": "3816.37", "fromAmount": "0.1"}`, validUntil.UnixMilli()))
+}
+
+func convertStatusData(status ConvertAcceptStatus) []byte {
+	return []byte(fmt.Sprintf(`{"orderId": "933256278426274426", "orderStatus": "%s", "fromAsset": "BTC",
+		"fromAmount": "0.1", "toAsset": "USDT", "toAmount": "3816.37", "ratio": "38163.7",
+		"inverseRatio": "0.0000262", "createTime": 1623381330472}`, status))
+}
+
+func (s *convertFlowTestSuite) TestSuccess() {
+	s.mockDoOnce(convertQuoteData(time.Now().Add(10*time.Second)), nil)
+	s.mockDoOnce([]byte(`{"orderId": "933256278426274426", "createTime": 1623381330472, "orderStatus": "PROCESS"}`), nil)
+	s.mockDoOnce(convertStatusData(ConvertAcceptStatusProcess), nil)
+	s.mockDoOnce(convertStatusData(ConvertAcceptStatusSuccess), nil)
+
+	res, err := s.client.NewConvertFlow("BTC", "USDT", "0.1").ValidTime(ConvertValidTime10S).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(ConvertAcceptStatusSuccess, res.OrderStatus)
+	r.Equal("933256278426274426", res.OrderId)
+	r.Equal("3816.37", res.ToAmount)
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+}
+
+func (s *convertFlowTestSuite) TestQuoteExpired() {
+	s.mockDoOnce(convertQuoteData(time.Now().Add(-time.Second)), nil)
+	s.mockDoOnce([]byte(`{"code": -2011, "msg": "quote expired"}`), nil, http.StatusBadRequest)
+
+	_, err := s.client.NewConvertFlow("BTC", "USDT", "0.1").Do(newContext())
+	r := s.r()
+	r.ErrorIs(err, ErrConvertQuoteExpired)
+	var apiErr *common.APIError
+	r.ErrorAs(err, &apiErr)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *convertFlowTestSuite) TestQuoteExpiredOnLocalClock() {
+	// the local clock is ahead of the exchange, the quote is still accepted
+	s.mockDoOnce(convertQuoteData(time.Now().Add(-time.Second)), nil)
+	s.mockDoOnce([]byte(`{"orderId": "933256278426274426", "createTime": 1623381330472, "orderStatus": "PROCESS"}`), nil)
+	s.mockDoOnce(convertStatusData(ConvertAcceptStatusSuccess), nil)
+
+	res, err := s.client.NewConvertFlow("BTC", "USDT", "0.1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(ConvertAcceptStatusSuccess, res.OrderStatus)
+}
+
+func (s *convertFlowTestSuite) TestAcceptTransportError() {
+	s.mockDoOnce(convertQuoteData(time.Now().Add(10*time.Second)), nil)
+	s.mockDoOnce(nil, errors.New("connection reset by peer"))
+	s.mockDoOnce(convertStatusData(ConvertAcceptStatusProcess), nil)
+	s.mockDoOnce(convertStatusData(ConvertAcceptStatusSuccess), nil)
+
+	// the quote was accepted, its order is followed until it is terminal
+	res, err := s.client.NewConvertFlow("BTC", "USDT", "0.1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(ConvertAcceptStatusSuccess, res.OrderStatus)
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+	status := s.client.Calls[2].Arguments.Get(0).(*http.Request)
+	s.r().Equal("/fapi/v1/convert/orderStatus", status.URL.Path)
+	s.r().Equal("12415572564", status.URL.Query().Get("quoteId"))
+}
+
+func (s *convertFlowTestSuite) TestAcceptTransportErrorNotAccepted() {
+	s.mockDoOnce(convertQuoteData(time.Now().Add(10*time.Second)), nil)
+	s.mockDoOnce(nil, errors.New("connection reset by peer"))
+	s.mockDoOnce([]byte(`{"code": -2013, "msg": "Order does not exist."}`), nil, http.StatusBadRequest)
+
+	_, err := s.client.NewConvertFlow("BTC", "USDT", "0.1").Do(newContext())
+	s.r().ErrorContains(err, "accept convert quote 12415572564")
+	s.r().ErrorContains(err, "connection reset by peer")
+	s.r().NotErrorIs(err, ErrConvertQuoteExpired)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *convertFlowTestSuite) TestAcceptContextDone() {
+	ctx, cancel := context.WithCancel(newContext())
+	defer cancel()
+	s.mockDoOnce(convertQuoteData(time.Now().Add(10*time.Second)), nil)
+	s.mockDoOnce(nil, context.Canceled)
+	// the response of the accept request is lost when ctx is done
+	s.client.ExpectedCalls[1].Run(func(args mock.Arguments) { cancel() })
+	s.mockDoOnce(convertStatusData(ConvertAcceptStatusProcess), nil)
+
+	res, err := s.client.NewConvertFlow("BTC", "USDT", "0.1").Do(ctx)
+	s.r().ErrorIs(err, context.Canceled)
+	s.r().Equal(ConvertAcceptStatusProcess, res.OrderStatus)
+	s.r().Equal("933256278426274426", res.OrderId)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *convertFlowTestSuite) TestFailed() {
+	s.mockDoOnce(convertQuoteData(time.Now().Add(10*time.Second)), nil)
+	s.mockDoOnce([]byte(`{"orderId": "933256278426274426", "createTime": 1623381330472, "orderStatus": "ACCEPT_SUCCESS"}`), nil)
+	s.mockDoOnce(convertStatusData(ConvertAcceptStatusFailed), nil)
+
+	res, err := s.client.NewConvertFlow("BTC", "USDT", "0.1").Do(newContext())
+	s.r().ErrorIs(err, ErrConvertFailed)
+	s.r().Equal(ConvertAcceptStatusFailed, res.OrderStatus)
+}
+
+func (s *convertFlowTestSuite) TestTimeout() {
+	s.mockDoOnce(convertQuoteData(time.Now().Add(10*time.Second)), nil)
+	s.mockDoOnce([]byte(`{"orderId": "933256278426274426", "createTime": 1623381330472, "orderStatus": "PROCESS"}`), nil)
+	for i := 0; i < 100; i++ {
+		s.mockDoOnce(convertStatusData(ConvertAcceptStatusProcess), nil)
+	}
+
+	res, err := s.client.NewConvertFlow("BTC", "USDT", "0.1").Timeout(20 * time.Millisecond).Do(newContext())
+	s.r().ErrorIs(err, ErrConvertTimeout)
+	s.r().Equal(ConvertAcceptStatusProcess, res.OrderStatus)
+}
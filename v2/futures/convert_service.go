@@ -2,7 +2,6 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 )
 
@@ -49,7 +48,7 @@ func (l *ListConvertExchangeInfoService) Do(ctx context.Context, opts ...Request
 		return nil, err
 	}
 	res = make([]ConvertExchangeInfo, 0, 50)
-	if err := json.Unmarshal(data, &res); err != nil {
+	if err := l.c.unmarshal(data, &res); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -132,7 +131,7 @@ func (c *CreateConvertQuoteService) Do(ctx context.Context, opts ...RequestOptio
 		return nil, err
 	}
 	res = new(ConvertQuote)
-	if err := json.Unmarshal(data, res); err != nil {
+	if err := c.c.unmarshal(data, res); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -178,7 +177,7 @@ func (c *ConvertAcceptService) Do(ctx context.Context, opts ...RequestOption) (r
 		return nil, err
 	}
 	res = new(ConvertResult)
-	if err := json.Unmarshal(data, res); err != nil {
+	if err := c.c.unmarshal(data, res); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -231,7 +230,7 @@ func (c *ConvertStatusService) Do(ctx context.Context, opts ...RequestOption) (r
 		return nil, err
 	}
 	res = new(ConvertStatusResult)
-	if err := json.Unmarshal(data, res); err != nil {
+	if err := c.c.unmarshal(data, res); err != nil {
 		return nil, err
 	}
 	return res, nil
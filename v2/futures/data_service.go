@@ -2,25 +2,35 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
+
+	"github.com/shopspring/decimal"
 )
 
+// DeliveryPriceService list the settlement prices of the quarterly contracts of a pair
 type DeliveryPriceService struct {
 	c    *Client
 	pair string
 }
 
+// DeliveryPrice define the settlement price of a quarterly contract
 type DeliveryPrice struct {
 	DeliveryTime  uint64  `json:"deliveryTime"`  // deliveryTime
 	DeliveryPrice float64 `json:"deliveryPrice"` // deliveryPrice
 }
 
+// DeliveryPriceDecimal return DeliveryPrice as a decimal, the API send it as a JSON number
+func (p *DeliveryPrice) DeliveryPriceDecimal() decimal.Decimal {
+	return decimal.NewFromFloat(p.DeliveryPrice)
+}
+
+// Pair set pair, for example BTCUSDT
 func (s *DeliveryPriceService) Pair(pair string) *DeliveryPriceService {
 	s.pair = pair
 	return s
 }
 
+// Do send request
 func (s *DeliveryPriceService) Do(ctx context.Context, opts ...RequestOption) (res []*DeliveryPrice, err error) {
 	r := &request{
 		method:   http.MethodGet,
@@ -32,7 +42,7 @@ func (s *DeliveryPriceService) Do(ctx context.Context, opts ...RequestOption) (r
 		return nil, err
 	}
 	res = make([]*DeliveryPrice, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +53,7 @@ func (s *DeliveryPriceService) Do(ctx context.Context, opts ...RequestOption) (r
 type TopLongShortAccountRatioService struct {
 	c         *Client
 	symbol    string
-	period    string  // "5m","15m","30m","1h","2h","4h","6h","12h","1d"
+	period    Period
 	limit     *uint32 // default 30, max 500
 	startTime *uint64
 	endTime   *uint64
@@ -57,12 +67,27 @@ type TopLongShortAccountRatio struct {
 	Timestamp      uint64 `json:"timestamp"`
 }
 
+// LongShortRatioDecimal return longShortRatio as a decimal
+func (r *TopLongShortAccountRatio) LongShortRatioDecimal() (decimal.Decimal, error) {
+	return parseDecimal("longShortRatio", r.LongShortRatio)
+}
+
+// LongAccountDecimal return longAccount as a decimal
+func (r *TopLongShortAccountRatio) LongAccountDecimal() (decimal.Decimal, error) {
+	return parseDecimal("longAccount", r.LongAccount)
+}
+
+// ShortAccountDecimal return shortAccount as a decimal
+func (r *TopLongShortAccountRatio) ShortAccountDecimal() (decimal.Decimal, error) {
+	return parseDecimal("shortAccount", r.ShortAccount)
+}
+
 func (s *TopLongShortAccountRatioService) Symbol(symbol string) *TopLongShortAccountRatioService {
 	s.symbol = symbol
 	return s
 }
 
-func (s *TopLongShortAccountRatioService) Period(period string) *TopLongShortAccountRatioService {
+func (s *TopLongShortAccountRatioService) Period(period Period) *TopLongShortAccountRatioService {
 	s.period = period
 	return s
 }
@@ -83,6 +108,9 @@ func (s *TopLongShortAccountRatioService) EndTime(endTime uint64) *TopLongShortA
 }
 
 func (s *TopLongShortAccountRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*TopLongShortAccountRatio, err error) {
+	if err = validatePeriod(s.period); err != nil {
+		return nil, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/futures/data/topLongShortAccountRatio",
@@ -104,7 +132,7 @@ func (s *TopLongShortAccountRatioService) Do(ctx context.Context, opts ...Reques
 		return nil, err
 	}
 	res = make([]*TopLongShortAccountRatio, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +143,7 @@ func (s *TopLongShortAccountRatioService) Do(ctx context.Context, opts ...Reques
 type TopLongShortPositionRatioService struct {
 	c         *Client
 	symbol    string
-	period    string  // "5m","15m","30m","1h","2h","4h","6h","12h","1d"
+	period    Period
 	limit     *uint32 // default 30, max 500
 	startTime *uint64
 	endTime   *uint64
@@ -129,12 +157,27 @@ type TopLongShortPositionRatio struct {
 	Timestamp      uint64 `json:"timestamp"`
 }
 
+// LongShortRatioDecimal return longShortRatio as a decimal
+func (r *TopLongShortPositionRatio) LongShortRatioDecimal() (decimal.Decimal, error) {
+	return parseDecimal("longShortRatio", r.LongShortRatio)
+}
+
+// LongAccountDecimal return longAccount as a decimal
+func (r *TopLongShortPositionRatio) LongAccountDecimal() (decimal.Decimal, error) {
+	return parseDecimal("longAccount", r.LongAccount)
+}
+
+// ShortAccountDecimal return shortAccount as a decimal
+func (r *TopLongShortPositionRatio) ShortAccountDecimal() (decimal.Decimal, error) {
+	return parseDecimal("shortAccount", r.ShortAccount)
+}
+
 func (s *TopLongShortPositionRatioService) Symbol(symbol string) *TopLongShortPositionRatioService {
 	s.symbol = symbol
 	return s
 }
 
-func (s *TopLongShortPositionRatioService) Period(period string) *TopLongShortPositionRatioService {
+func (s *TopLongShortPositionRatioService) Period(period Period) *TopLongShortPositionRatioService {
 	s.period = period
 	return s
 }
@@ -155,6 +198,9 @@ func (s *TopLongShortPositionRatioService) EndTime(endTime uint64) *TopLongShort
 }
 
 func (s *TopLongShortPositionRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*TopLongShortPositionRatio, err error) {
+	if err = validatePeriod(s.period); err != nil {
+		return nil, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/futures/data/topLongShortPositionRatio",
@@ -176,7 +222,7 @@ func (s *TopLongShortPositionRatioService) Do(ctx context.Context, opts ...Reque
 		return nil, err
 	}
 	res = make([]*TopLongShortPositionRatio, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +233,7 @@ func (s *TopLongShortPositionRatioService) Do(ctx context.Context, opts ...Reque
 type TakerLongShortRatioService struct {
 	c         *Client
 	symbol    string
-	period    string  // "5m","15m","30m","1h","2h","4h","6h","12h","1d"
+	period    Period
 	limit     *uint32 // default 30, max 500
 	startTime *uint64
 	endTime   *uint64
@@ -200,12 +246,27 @@ type TakerLongShortRatio struct {
 	Timestamp    uint64 `json:"timestamp"`
 }
 
+// BuySellRatioDecimal return buySellRatio as a decimal
+func (r *TakerLongShortRatio) BuySellRatioDecimal() (decimal.Decimal, error) {
+	return parseDecimal("buySellRatio", r.BuySellRatio)
+}
+
+// BuyVolDecimal return buyVol as a decimal
+func (r *TakerLongShortRatio) BuyVolDecimal() (decimal.Decimal, error) {
+	return parseDecimal("buyVol", r.BuyVol)
+}
+
+// SellVolDecimal return sellVol as a decimal
+func (r *TakerLongShortRatio) SellVolDecimal() (decimal.Decimal, error) {
+	return parseDecimal("sellVol", r.SellVol)
+}
+
 func (s *TakerLongShortRatioService) Symbol(symbol string) *TakerLongShortRatioService {
 	s.symbol = symbol
 	return s
 }
 
-func (s *TakerLongShortRatioService) Period(period string) *TakerLongShortRatioService {
+func (s *TakerLongShortRatioService) Period(period Period) *TakerLongShortRatioService {
 	s.period = period
 	return s
 }
@@ -226,6 +287,9 @@ func (s *TakerLongShortRatioService) EndTime(endTime uint64) *TakerLongShortRati
 }
 
 func (s *TakerLongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*TakerLongShortRatio, err error) {
+	if err = validatePeriod(s.period); err != nil {
+		return nil, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/futures/data/takerlongshortRatio",
@@ -247,7 +311,7 @@ func (s *TakerLongShortRatioService) Do(ctx context.Context, opts ...RequestOpti
 		return nil, err
 	}
 	res = make([]*TakerLongShortRatio, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -276,6 +340,50 @@ type Basis struct {
 	Timestamp           uint64 `json:"timestamp"`
 }
 
+// IndexPriceDecimal return indexPrice as a decimal
+func (b *Basis) IndexPriceDecimal() (decimal.Decimal, error) {
+	return parseDecimal("indexPrice", b.IndexPrice)
+}
+
+// BasisRateDecimal return basisRate as a decimal
+func (b *Basis) BasisRateDecimal() (decimal.Decimal, error) {
+	return parseDecimal("basisRate", b.BasisRate)
+}
+
+// FuturesPriceDecimal return futuresPrice as a decimal
+func (b *Basis) FuturesPriceDecimal() (decimal.Decimal, error) {
+	return parseDecimal("futuresPrice", b.FuturesPrice)
+}
+
+// AnnualizedBasisRateDecimal return annualizedBasisRate as a decimal
+func (b *Basis) AnnualizedBasisRateDecimal() (decimal.Decimal, error) {
+	return parseDecimal("annualizedBasisRate", b.AnnualizedBasisRate)
+}
+
+// BasisDecimal return basis as a decimal
+func (b *Basis) BasisDecimal() (decimal.Decimal, error) {
+	return parseDecimal("basis", b.Basis)
+}
+
+// AnnualizedBasis return the basis rate of b annualized over daysToExpiry days: (futuresPrice - indexPrice) / indexPrice * 365 / daysToExpiry.
+// The rate is computed from the prices as basisRate is rounded to 4 decimals. Zero is returned when the prices
+// can not be parsed, the index price is zero or daysToExpiry is not positive.
+func AnnualizedBasis(b *Basis, daysToExpiry float64) decimal.Decimal {
+	if daysToExpiry <= 0 {
+		return decimal.Zero
+	}
+	indexPrice, err := b.IndexPriceDecimal()
+	if err != nil || indexPrice.IsZero() {
+		return decimal.Zero
+	}
+	futuresPrice, err := b.FuturesPriceDecimal()
+	if err != nil {
+		return decimal.Zero
+	}
+	rate := futuresPrice.Sub(indexPrice).Div(indexPrice)
+	return rate.Mul(decimal.NewFromInt(365)).Div(decimal.NewFromFloat(daysToExpiry))
+}
+
 func (s *BasisService) Pair(pair string) *BasisService {
 	s.pair = pair
 	return s
@@ -327,7 +435,7 @@ func (s *BasisService) Do(ctx context.Context, opts ...RequestOption) (res []*Ba
 		return nil, err
 	}
 	res = make([]*Basis, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
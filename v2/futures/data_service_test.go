@@ -3,6 +3,8 @@ package futures
 import (
 	"testing"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/stretchr/testify/suite"
 )
 
@@ -45,6 +47,26 @@ func (s *deliveryPriceServiceTestSuite) TestDeliveryPrice() {
 	s.assertDeliveryPricesEqual(e, res)
 }
 
+func (s *deliveryPriceServiceTestSuite) TestDeliveryPriceHistory() {
+	data := []byte(`[
+		{"deliveryTime": 1727424000000, "deliveryPrice": 65712.1},
+		{"deliveryTime": 1719475200000, "deliveryPrice": 61312.3},
+		{"deliveryTime": 1711670400000, "deliveryPrice": 70151.5},
+		{"deliveryTime": 1703808000000, "deliveryPrice": 42388}
+	]`)
+	s.mockDoOnce(data, nil)
+
+	res, err := s.client.NewDeliveryPriceService().Pair("BTCUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 4)
+	r.Equal(uint64(1727424000000), res[0].DeliveryTime)
+	r.Equal("65712.1", res[0].DeliveryPriceDecimal().String())
+	r.Equal("61312.3", res[1].DeliveryPriceDecimal().String())
+	r.Equal(uint64(1703808000000), res[3].DeliveryTime)
+	r.Equal("42388", res[3].DeliveryPriceDecimal().String())
+}
+
 func (s *deliveryPriceServiceTestSuite) assertDeliveryPriceEqual(e, a *DeliveryPrice) {
 	r := s.r()
 	r.Equal(e.DeliveryTime, a.DeliveryTime, "DeliveryTime")
@@ -85,7 +107,7 @@ func (s *topLongShortAccountRatioServiceTestSuite) TestTopLongShortAccountRatio(
 	s.mockDo(data, nil)
 	defer s.assertDo()
 	var symbol string = "BTCUSDT"
-	var period string = "15m"
+	var period = Period15m
 	var limit uint32 = 30
 	s.assertReq(func(r *request) {
 		e := newRequest().setParam("symbol", symbol).setParam("period", period).setParam("limit", limit)
@@ -154,7 +176,7 @@ func (s *topLongShortPositionRatioServiceTestSuite) TestTopLongShortPositionRati
 	s.mockDo(data, nil)
 	defer s.assertDo()
 	var symbol string = "BTCUSDT"
-	var period string = "15m"
+	var period = Period15m
 	var limit uint32 = 30
 	s.assertReq(func(r *request) {
 		e := newRequest().setParam("symbol", symbol).setParam("period", period).setParam("limit", limit)
@@ -221,7 +243,7 @@ func (s *takerLongShortRatioServiceTestSuite) TestTakerLongShortRatio() {
 	s.mockDo(data, nil)
 	defer s.assertDo()
 	var symbol string = "BTCUSDT"
-	var period string = "15m"
+	var period = Period15m
 	var limit uint32 = 30
 	s.assertReq(func(r *request) {
 		e := newRequest().setParam("symbol", symbol).setParam("period", period).setParam("limit", limit)
@@ -326,6 +348,47 @@ func (s *basisServiceTestSuite) TestBasis() {
 	s.assertBasissEqual(e, res)
 }
 
+func (s *basisServiceTestSuite) TestBasisDecimal() {
+	data := []byte(`[{
+		"indexPrice": "62059.81936170",
+		"contractType": "CURRENT_QUARTER",
+		"basisRate": "-0.0002",
+		"futuresPrice": "62046.6",
+		"annualizedBasisRate": "",
+		"basis": "-13.21936170",
+		"pair": "BTCUSDT",
+		"timestamp": 1719499500000
+	}]`)
+	s.mockDoOnce(data, nil)
+
+	res, err := s.client.NewBasisService().Pair("BTCUSDT").ContractType("CURRENT_QUARTER").Period("15m").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	b := res[0]
+	indexPrice, err := b.IndexPriceDecimal()
+	r.NoError(err)
+	r.Equal("62059.8193617", indexPrice.String())
+	futuresPrice, err := b.FuturesPriceDecimal()
+	r.NoError(err)
+	r.Equal("62046.6", futuresPrice.String())
+	basis, err := b.BasisDecimal()
+	r.NoError(err)
+	r.Equal("-13.2193617", basis.String())
+	basisRate, err := b.BasisRateDecimal()
+	r.NoError(err)
+	r.Equal("-0.0002", basisRate.String())
+	_, err = b.AnnualizedBasisRateDecimal()
+	r.Error(err)
+
+	// -13.2193617 / 62059.8193617 * 365 / 90
+	r.Equal("-0.000864", AnnualizedBasis(b, 90).Round(6).String())
+	r.Equal("-0.001728", AnnualizedBasis(b, 45).Round(6).String())
+	r.True(AnnualizedBasis(b, 0).IsZero())
+	r.True(AnnualizedBasis(&Basis{IndexPrice: "0", FuturesPrice: "1"}, 90).IsZero())
+	r.True(AnnualizedBasis(&Basis{IndexPrice: "100", FuturesPrice: "101"}, 365).Equal(decimal.RequireFromString("0.01")))
+}
+
 func (s *basisServiceTestSuite) assertBasisEqual(e, a *Basis) {
 	r := s.r()
 	r.Equal(e.Pair, a.Pair, "Pair")
@@ -344,3 +407,62 @@ func (s *basisServiceTestSuite) assertBasissEqual(e, a []*Basis) {
 		s.assertBasisEqual(e[i], a[i])
 	}
 }
+
+func (s *topLongShortAccountRatioServiceTestSuite) TestTopLongShortAccountRatioDecimal() {
+	s.mockDo([]byte(`[{"symbol": "BTCUSDT", "longAccount": "0.7106", "longShortRatio": "2.4554", "shortAccount": "0.2894", "timestamp": 1719517500000}]`), nil)
+	res, err := s.client.NewTopLongShortAccountRatioService().Symbol("BTCUSDT").Period(Period1h).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	ratio, err := res[0].LongShortRatioDecimal()
+	r.NoError(err)
+	r.Equal("2.4554", ratio.String())
+	long, err := res[0].LongAccountDecimal()
+	r.NoError(err)
+	short, err := res[0].ShortAccountDecimal()
+	r.NoError(err)
+	r.Equal("1", long.Add(short).String())
+
+	_, err = s.client.NewTopLongShortAccountRatioService().Symbol("BTCUSDT").Period("1w").Do(newContext())
+	r.EqualError(err, `invalid period "1w"`)
+}
+
+func (s *topLongShortPositionRatioServiceTestSuite) TestTopLongShortPositionRatioDecimal() {
+	s.mockDo([]byte(`[{"symbol": "BTCUSDT", "longAccount": "0.6442", "longShortRatio": "1.8105", "shortAccount": "0.3558", "timestamp": 1719517500000}]`), nil)
+	res, err := s.client.NewTopLongShortPositionRatioService().Symbol("BTCUSDT").Period(Period4h).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	ratio, err := res[0].LongShortRatioDecimal()
+	r.NoError(err)
+	r.Equal("1.8105", ratio.String())
+	long, err := res[0].LongAccountDecimal()
+	r.NoError(err)
+	r.Equal("0.6442", long.String())
+	short, err := res[0].ShortAccountDecimal()
+	r.NoError(err)
+	r.Equal("0.3558", short.String())
+
+	_, err = s.client.NewTopLongShortPositionRatioService().Symbol("BTCUSDT").Do(newContext())
+	r.EqualError(err, `invalid period ""`)
+}
+
+func (s *takerLongShortRatioServiceTestSuite) TestTakerLongShortRatioDecimal() {
+	s.mockDo([]byte(`[{"buySellRatio": "1.5586", "buyVol": "387.3300", "sellVol": "248.5030", "timestamp": 1585614900000}]`), nil)
+	res, err := s.client.NewTakerLongShortRatioService().Symbol("BTCUSDT").Period(Period5m).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	ratio, err := res[0].BuySellRatioDecimal()
+	r.NoError(err)
+	r.Equal("1.5586", ratio.String())
+	buy, err := res[0].BuyVolDecimal()
+	r.NoError(err)
+	r.Equal("387.33", buy.String())
+	sell, err := res[0].SellVolDecimal()
+	r.NoError(err)
+	r.Equal("248.503", sell.String())
+
+	_, err = s.client.NewTakerLongShortRatioService().Symbol("BTCUSDT").Period("10m").Do(newContext())
+	r.EqualError(err, `invalid period "10m"`)
+}
@@ -0,0 +1,108 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coin-quant/go-aster/v2/common"
+	"github.com/shopspring/decimal"
+)
+
+// ErrEmptyBook is returned when a depth has no bid or no ask
+var ErrEmptyBook = errors.New("depth has no bid or no ask")
+
+// MidPrice return the average of best bid and best ask price, zero when it can't be computed
+func MidPrice(book *DepthResponse) decimal.Decimal {
+	price, _ := MidPriceWithError(book)
+	return price
+}
+
+// MidPriceWithError return the average of best bid and best ask price
+func MidPriceWithError(book *DepthResponse) (decimal.Decimal, error) {
+	bidPrice, _, askPrice, _, err := topOfBook(book)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return bidPrice.Add(askPrice).Div(decimal.NewFromInt(2)), nil
+}
+
+// Microprice return the best bid and best ask prices weighted by the size of the opposite side,
+// zero when it can't be computed
+func Microprice(book *DepthResponse) decimal.Decimal {
+	price, _ := MicropriceWithError(book)
+	return price
+}
+
+// MicropriceWithError return (bidPrice * askQty + askPrice * bidQty) / (bidQty + askQty) of the top of book
+func MicropriceWithError(book *DepthResponse) (decimal.Decimal, error) {
+	bidPrice, bidQty, askPrice, askQty, err := topOfBook(book)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	size := bidQty.Add(askQty)
+	if size.IsZero() {
+		return decimal.Zero, errors.New("top of book has no quantity")
+	}
+	return bidPrice.Mul(askQty).Add(askPrice.Mul(bidQty)).Div(size), nil
+}
+
+func topOfBook(book *DepthResponse) (bidPrice, bidQty, askPrice, askQty decimal.Decimal, err error) {
+	if book == nil || len(book.Bids) == 0 || len(book.Asks) == 0 {
+		err = ErrEmptyBook
+		return
+	}
+	if bidPrice, err = parseDecimal("bid price", book.Bids[0].Price); err != nil {
+		return
+	}
+	if bidQty, err = parseDecimal("bid quantity", book.Bids[0].Quantity); err != nil {
+		return
+	}
+	if askPrice, err = parseDecimal("ask price", book.Asks[0].Price); err != nil {
+		return
+	}
+	askQty, err = parseDecimal("ask quantity", book.Asks[0].Quantity)
+	return
+}
+
+// OrderBookImbalance return (bidVol - askVol) / (bidVol + askVol) over the top levels of each side,
+// zero when it can't be computed
+func OrderBookImbalance(book *DepthResponse, levels int) decimal.Decimal {
+	imbalance, _ := OrderBookImbalanceWithError(book, levels)
+	return imbalance
+}
+
+// OrderBookImbalanceWithError return (bidVol - askVol) / (bidVol + askVol) over the top levels of each side,
+// a side with less levels is summed entirely. The result is between -1 (asks only) and 1 (bids only).
+func OrderBookImbalanceWithError(book *DepthResponse, levels int) (decimal.Decimal, error) {
+	if levels <= 0 {
+		return decimal.Zero, fmt.Errorf("levels must be positive, got %d", levels)
+	}
+	if book == nil {
+		return decimal.Zero, ErrEmptyBook
+	}
+	bidVol, err := depthVolume("bid quantity", book.Bids, levels)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	askVol, err := depthVolume("ask quantity", book.Asks, levels)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	total := bidVol.Add(askVol)
+	if total.IsZero() {
+		return decimal.Zero, ErrEmptyBook
+	}
+	return bidVol.Sub(askVol).Div(total), nil
+}
+
+func depthVolume(name string, side []common.PriceLevel, levels int) (decimal.Decimal, error) {
+	volume := decimal.Zero
+	for i := 0; i < len(side) && i < levels; i++ {
+		qty, err := parseDecimal(name, side[i].Quantity)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		volume = volume.Add(qty)
+	}
+	return volume, nil
+}
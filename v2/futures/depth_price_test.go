@@ -0,0 +1,97 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepthPrices(t *testing.T) {
+	tests := []struct {
+		name       string
+		bids       []Bid
+		asks       []Ask
+		mid        string
+		microprice string
+	}{
+		{
+			name:       "balanced book",
+			bids:       []Bid{{Price: "100", Quantity: "5"}, {Price: "99", Quantity: "50"}},
+			asks:       []Ask{{Price: "102", Quantity: "5"}, {Price: "103", Quantity: "50"}},
+			mid:        "101",
+			microprice: "101",
+		},
+		{
+			name:       "heavy bid",
+			bids:       []Bid{{Price: "100", Quantity: "3"}},
+			asks:       []Ask{{Price: "102", Quantity: "1"}},
+			mid:        "101",
+			microprice: "101.5",
+		},
+		{
+			name:       "heavy ask",
+			bids:       []Bid{{Price: "60000.1", Quantity: "1"}},
+			asks:       []Ask{{Price: "60000.3", Quantity: "3"}},
+			mid:        "60000.2",
+			microprice: "60000.15",
+		},
+	}
+	for _, tt := range tests {
+		book := &DepthResponse{Bids: tt.bids, Asks: tt.asks}
+		require.Equal(t, tt.mid, MidPrice(book).String(), tt.name)
+		require.Equal(t, tt.microprice, Microprice(book).String(), tt.name)
+	}
+}
+
+func TestDepthPricesEmptyBook(t *testing.T) {
+	for _, book := range []*DepthResponse{
+		nil,
+		{},
+		{Bids: []Bid{{Price: "100", Quantity: "1"}}},
+		{Asks: []Ask{{Price: "100", Quantity: "1"}}},
+	} {
+		require.True(t, MidPrice(book).IsZero())
+		require.True(t, Microprice(book).IsZero())
+		_, err := MidPriceWithError(book)
+		require.ErrorIs(t, err, ErrEmptyBook)
+		_, err = MicropriceWithError(book)
+		require.ErrorIs(t, err, ErrEmptyBook)
+	}
+
+	_, err := MicropriceWithError(&DepthResponse{Bids: []Bid{{Price: "100", Quantity: "0"}}, Asks: []Ask{{Price: "101", Quantity: "0"}}})
+	require.EqualError(t, err, "top of book has no quantity")
+	_, err = MidPriceWithError(&DepthResponse{Bids: []Bid{{Price: "x", Quantity: "1"}}, Asks: []Ask{{Price: "101", Quantity: "1"}}})
+	require.ErrorContains(t, err, `invalid bid price "x"`)
+}
+
+func TestOrderBookImbalance(t *testing.T) {
+	book := &DepthResponse{
+		Bids: []Bid{{Price: "100", Quantity: "3"}, {Price: "99", Quantity: "1"}, {Price: "98", Quantity: "6"}},
+		Asks: []Ask{{Price: "101", Quantity: "1"}, {Price: "102", Quantity: "4"}},
+	}
+	tests := []struct {
+		levels int
+		want   string
+	}{
+		{1, "0.5"},                 // (3 - 1) / (3 + 1)
+		{2, "-0.1111111111111111"}, // (4 - 5) / (4 + 5)
+		{3, "0.3333333333333333"},  // (10 - 5) / (10 + 5), asks have only two levels
+		{10, "0.3333333333333333"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, OrderBookImbalance(book, tt.levels).String(), tt.levels)
+	}
+
+	require.Equal(t, "1", OrderBookImbalance(&DepthResponse{Bids: book.Bids}, 5).String())
+	require.Equal(t, "-1", OrderBookImbalance(&DepthResponse{Asks: book.Asks}, 5).String())
+
+	for _, empty := range []*DepthResponse{nil, {}, {Bids: []Bid{{Price: "100", Quantity: "0"}}}} {
+		require.True(t, OrderBookImbalance(empty, 5).IsZero())
+		_, err := OrderBookImbalanceWithError(empty, 5)
+		require.ErrorIs(t, err, ErrEmptyBook)
+	}
+	_, err := OrderBookImbalanceWithError(book, 0)
+	require.EqualError(t, err, "levels must be positive, got 0")
+	_, err = OrderBookImbalanceWithError(&DepthResponse{Asks: []Ask{{Price: "101", Quantity: "x"}}}, 1)
+	require.ErrorContains(t, err, `invalid ask quantity "x"`)
+}
@@ -40,35 +40,35 @@ func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *Dept
 	if err != nil {
 		return nil, err
 	}
-	j, err := newJSON(data)
-	if err != nil {
+	raw := new(depthResponse)
+	if err = s.c.unmarshal(data, raw); err != nil {
 		return nil, err
 	}
-	res = new(DepthResponse)
-	res.Time = j.Get("E").MustInt64()
-	res.TradeTime = j.Get("T").MustInt64()
-	res.LastUpdateID = j.Get("lastUpdateId").MustInt64()
-	bidsLen := len(j.Get("bids").MustArray())
-	res.Bids = make([]Bid, bidsLen)
-	for i := 0; i < bidsLen; i++ {
-		item := j.Get("bids").GetIndex(i)
-		res.Bids[i] = Bid{
-			Price:    item.GetIndex(0).MustString(),
-			Quantity: item.GetIndex(1).MustString(),
-		}
+	res = &DepthResponse{
+		LastUpdateID: raw.LastUpdateID,
+		Time:         raw.Time,
+		TradeTime:    raw.TradeTime,
+		Bids:         make([]Bid, len(raw.Bids)),
+		Asks:         make([]Ask, len(raw.Asks)),
+	}
+	for i, item := range raw.Bids {
+		res.Bids[i] = Bid{Price: item[0], Quantity: item[1]}
 	}
-	asksLen := len(j.Get("asks").MustArray())
-	res.Asks = make([]Ask, asksLen)
-	for i := 0; i < asksLen; i++ {
-		item := j.Get("asks").GetIndex(i)
-		res.Asks[i] = Ask{
-			Price:    item.GetIndex(0).MustString(),
-			Quantity: item.GetIndex(1).MustString(),
-		}
+	for i, item := range raw.Asks {
+		res.Asks[i] = Ask{Price: item[0], Quantity: item[1]}
 	}
 	return res, nil
 }
 
+// depthResponse is the depth as it is sent, the price levels are [price, quantity] pairs
+type depthResponse struct {
+	LastUpdateID int64       `json:"lastUpdateId"`
+	Time         int64       `json:"E"`
+	TradeTime    int64       `json:"T"`
+	Bids         [][2]string `json:"bids"`
+	Asks         [][2]string `json:"asks"`
+}
+
 // DepthResponse define depth info with bids and asks
 type DepthResponse struct {
 	LastUpdateID int64 `json:"lastUpdateId"`
@@ -59,6 +59,15 @@ func (s *depthServiceTestSuite) TestDepth() {
 	s.assertDepthResponseEqual(e, res)
 }
 
+func (s *depthServiceTestSuite) TestDepthStrictJSON() {
+	s.mockDo([]byte(`{"lastUpdateId": 1027024, "E": 1589436922972, "T": 1589436922959, "bids": [], "asks": [], "pair": "LTCBTC"}`), nil)
+	defer s.assertDo()
+
+	s.client.StrictJSON = true
+	_, err := s.client.NewDepthService().Symbol("LTCBTC").Do(newContext())
+	s.r().EqualError(err, `json: unknown field "pair"`)
+}
+
 func (s *depthServiceTestSuite) assertDepthResponseEqual(e, a *DepthResponse) {
 	r := s.r()
 	r.Equal(e.LastUpdateID, a.LastUpdateID, "LastUpdateID")
@@ -0,0 +1,79 @@
+package futures
+
+import (
+	"errors"
+
+	"github.com/coin-quant/go-aster/v2/common"
+)
+
+// Exchange error codes of the order rejections callers commonly branch on
+const (
+	// ErrCodeInsufficientMargin is returned when the margin is insufficient for the order
+	ErrCodeInsufficientMargin = -2019
+	// ErrCodeReduceOnlyRejected is returned when a reduceOnly order would increase the position
+	ErrCodeReduceOnlyRejected = -2022
+)
+
+// IsInsufficientMargin return true when err wrap an APIError with code -2019
+func IsInsufficientMargin(err error) bool {
+	return hasErrorCode(err, ErrCodeInsufficientMargin)
+}
+
+// IsReduceOnlyRejected return true when err wrap an APIError with code -2022
+func IsReduceOnlyRejected(err error) bool {
+	return hasErrorCode(err, ErrCodeReduceOnlyRejected)
+}
+
+// ErrorCode return the exchange error code of the APIError wrapped by err, false when there is none
+func ErrorCode(err error) (int, bool) {
+	var apiErr *common.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code == 0 {
+		return 0, false
+	}
+	return int(apiErr.Code), true
+}
+
+func hasErrorCode(err error, code int) bool {
+	c, ok := ErrorCode(err)
+	return ok && c == code
+}
+
+// ErrorCodes describe the known futures error codes, e.g. to log the code of an APIError with a stable description.
+// It is not exhaustive, codes missing from it may still be returned by the exchange.
+var ErrorCodes = map[int]string{
+	-1000: "unknown error while processing the request",
+	-1001: "internal error, unable to process the request",
+	-1002: "unauthorized to execute this request",
+	-1003: "too many requests",
+	-1006: "unexpected response from the message bus",
+	-1007: "timeout waiting for response from backend server",
+	-1013: "filter failure",
+	-1014: "unsupported order combination",
+	-1015: "too many new orders",
+	-1016: "service shutting down",
+	-1021: "timestamp outside of the recvWindow",
+	-1022: "invalid signature",
+	-1102: "mandatory parameter empty or malformed",
+	-1111: "precision over the maximum defined for this asset",
+	-1116: "invalid order type",
+	-1117: "invalid side",
+	-1121: "invalid symbol",
+	-2010: "new order rejected",
+	-2011: "cancel rejected",
+	-2013: "order does not exist",
+	-2014: "API-key format invalid",
+	-2015: "invalid API-key, IP, or permissions for action",
+	-2018: "balance is insufficient",
+	-2019: "margin is insufficient",
+	-2020: "unable to fill",
+	-2021: "order would immediately trigger",
+	-2022: "reduceOnly order is rejected",
+	-2024: "position is not sufficient",
+	-2025: "reach max open order limit",
+	-4003: "quantity less than or equal to zero",
+	-4046: "no need to change margin type",
+	-4059: "no need to change position side",
+	-4061: "order's position side does not match user's setting",
+	-4164: "order's notional must be no smaller than the minimum notional",
+	-5022: "post only order would not be executed as maker",
+}
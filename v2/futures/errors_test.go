@@ -0,0 +1,39 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/coin-quant/go-aster/v2/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorCodePredicates(t *testing.T) {
+	insufficientMargin := &common.APIError{Code: -2019, Message: "Margin is insufficient."}
+	reduceOnlyRejected := &common.APIError{Code: -2022, Message: "ReduceOnly Order is rejected."}
+
+	require.True(t, IsInsufficientMargin(insufficientMargin))
+	require.True(t, IsInsufficientMargin(fmt.Errorf("place order: %w", insufficientMargin)))
+	require.False(t, IsInsufficientMargin(reduceOnlyRejected))
+
+	require.True(t, IsReduceOnlyRejected(reduceOnlyRejected))
+	require.True(t, IsReduceOnlyRejected(fmt.Errorf("batchOrders[1]: %w", reduceOnlyRejected)))
+	require.False(t, IsReduceOnlyRejected(insufficientMargin))
+
+	require.False(t, IsInsufficientMargin(nil))
+	require.False(t, IsReduceOnlyRejected(errors.New("code -2022")))
+}
+
+func TestErrorCode(t *testing.T) {
+	err := fmt.Errorf("place order: %w", &common.APIError{Code: -1021, Message: "Timestamp for this request is outside of the recvWindow."})
+	code, ok := ErrorCode(err)
+	require.True(t, ok)
+	require.Equal(t, -1021, code)
+	require.Equal(t, "timestamp outside of the recvWindow", ErrorCodes[code])
+
+	_, ok = ErrorCode(&common.APIError{Response: []byte("<html>bad gateway</html>")})
+	require.False(t, ok)
+	_, ok = ErrorCode(errors.New("connection reset"))
+	require.False(t, ok)
+}
@@ -3,14 +3,25 @@ package futures
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/coin-quant/go-aster/v2/common"
 )
 
 // ExchangeInfoService exchange info service
 type ExchangeInfoService struct {
-	c *Client
+	c      *Client
+	filter func(s *Symbol) bool
+}
+
+// SymbolFilter keep only the symbols for which filter return true. The response is then decoded while
+// it is read, one symbol at a time, so that the symbols filtered out are never held in memory together.
+func (s *ExchangeInfoService) SymbolFilter(filter func(s *Symbol) bool) *ExchangeInfoService {
+	s.filter = filter
+	return s
 }
 
 // Do send request
@@ -20,12 +31,22 @@ func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (re
 		"method": http.MethodGet,
 		"params": map[string]interface{}{},
 	}
-	data, err := s.c.call(m, false)
+	if s.filter != nil {
+		err = s.c.stream(ctx, http.MethodGet, "/fapi/v1/exchangeInfo", map[string]interface{}{}, false, func(r io.Reader) error {
+			res, err = s.c.decodeExchangeInfo(r, s.filter)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+	data, err := s.c.call(ctx, m, false, opts...)
 	if err != nil {
 		return nil, err
 	}
 	res = new(ExchangeInfo)
-	err = json.Unmarshal(data, res)
+	err = s.c.unmarshal(data, res)
 	if err != nil {
 		return nil, err
 	}
@@ -33,6 +54,79 @@ func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (re
 	return res, nil
 }
 
+// decodeExchangeInfo decode an exchange info from r, the symbols are decoded one by one and only those
+// accepted by filter are kept
+func (c *Client) decodeExchangeInfo(r io.Reader, filter func(s *Symbol) bool) (*ExchangeInfo, error) {
+	d := json.NewDecoder(r)
+	if c.StrictJSON {
+		d.DisallowUnknownFields()
+	}
+	if err := expectDelim(d, '{'); err != nil {
+		return nil, err
+	}
+	res := new(ExchangeInfo)
+	// the fields other than symbols are small, they are decoded together once the object is read
+	others := map[string]json.RawMessage{}
+	for d.More() {
+		t, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := t.(string)
+		if key != "symbols" {
+			var raw json.RawMessage
+			if err := d.Decode(&raw); err != nil {
+				return nil, err
+			}
+			others[key] = raw
+			continue
+		}
+		if t, err = d.Token(); err != nil {
+			return nil, err
+		}
+		if t == nil {
+			continue
+		}
+		if t != json.Delim('[') {
+			return nil, fmt.Errorf("unexpected %v for symbols", t)
+		}
+		for d.More() {
+			symbol := Symbol{}
+			if err := d.Decode(&symbol); err != nil {
+				return nil, err
+			}
+			if filter(&symbol) {
+				res.Symbols = append(res.Symbols, symbol)
+			}
+		}
+		if err := expectDelim(d, ']'); err != nil {
+			return nil, err
+		}
+	}
+	if err := expectDelim(d, '}'); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(others)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func expectDelim(d *json.Decoder, delim json.Delim) error {
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	if t != delim {
+		return fmt.Errorf("expected %v, got %v", delim, t)
+	}
+	return nil
+}
+
 // ExchangeInfo exchange info
 type ExchangeInfo struct {
 	Timezone        string        `json:"timezone"`
@@ -121,128 +215,144 @@ type MinNotionalFilter struct {
 	Notional string `json:"notional"`
 }
 
-// LotSizeFilter return lot size filter of symbol
-func (s *Symbol) LotSizeFilter() *LotSizeFilter {
-	for _, filter := range s.Filters {
-		if filter["filterType"].(string) == string(SymbolFilterTypeLotSize) {
-			f := &LotSizeFilter{}
-			if i, ok := filter["maxQty"]; ok {
-				f.MaxQuantity = i.(string)
-			}
-			if i, ok := filter["minQty"]; ok {
-				f.MinQuantity = i.(string)
-			}
-			if i, ok := filter["stepSize"]; ok {
-				f.StepSize = i.(string)
-			}
-			return f
+// Symbol return the symbol named symbol, nil when it is not listed. Fetch the exchange info once and look up
+// the symbols in it rather than requesting it for each symbol.
+func (e *ExchangeInfo) Symbol(symbol string) *Symbol {
+	for i := range e.Symbols {
+		if e.Symbols[i].Symbol == symbol {
+			return &e.Symbols[i]
 		}
 	}
 	return nil
 }
 
-// PriceFilter return price filter of symbol
-func (s *Symbol) PriceFilter() *PriceFilter {
+// TradableSymbols return the symbols in TRADING status quoted in quoteAsset, e.g. USDT, in exchange info order
+func (e *ExchangeInfo) TradableSymbols(quoteAsset string) []string {
+	symbols := make([]string, 0)
+	for _, s := range e.Symbols {
+		if s.Status == string(SymbolStatusTypeTrading) && s.QuoteAsset == quoteAsset {
+			symbols = append(symbols, s.Symbol)
+		}
+	}
+	return symbols
+}
+
+// IsTradable return true if symbol is listed and in TRADING status
+func (e *ExchangeInfo) IsTradable(symbol string) bool {
+	s := e.Symbol(symbol)
+	return s != nil && s.Status == string(SymbolStatusTypeTrading)
+}
+
+// filter return the filter of symbol with filterType, nil when there is none
+func (s *Symbol) filter(filterType SymbolFilterType) map[string]interface{} {
 	for _, filter := range s.Filters {
-		if filter["filterType"].(string) == string(SymbolFilterTypePrice) {
-			f := &PriceFilter{}
-			if i, ok := filter["maxPrice"]; ok {
-				f.MaxPrice = i.(string)
-			}
-			if i, ok := filter["minPrice"]; ok {
-				f.MinPrice = i.(string)
-			}
-			if i, ok := filter["tickSize"]; ok {
-				f.TickSize = i.(string)
-			}
-			return f
+		if t, ok := filter["filterType"].(string); ok && t == string(filterType) {
+			return filter
 		}
 	}
 	return nil
 }
 
+// filterString return the value of key in filter as a string, numbers are formatted as they are sent
+func filterString(filter map[string]interface{}, key string) string {
+	switch v := filter[key].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+// filterInt64 return the value of key in filter as an int64, 0 when it is absent or not a number
+func filterInt64(filter map[string]interface{}, key string) int64 {
+	i, ok := filter[key]
+	if !ok {
+		return 0
+	}
+	limit, err := common.ToInt64(i)
+	if err != nil {
+		return 0
+	}
+	return limit
+}
+
+// LotSizeFilter return lot size filter of symbol
+func (s *Symbol) LotSizeFilter() *LotSizeFilter {
+	filter := s.filter(SymbolFilterTypeLotSize)
+	if filter == nil {
+		return nil
+	}
+	return &LotSizeFilter{
+		MaxQuantity: filterString(filter, "maxQty"),
+		MinQuantity: filterString(filter, "minQty"),
+		StepSize:    filterString(filter, "stepSize"),
+	}
+}
+
+// PriceFilter return price filter of symbol
+func (s *Symbol) PriceFilter() *PriceFilter {
+	filter := s.filter(SymbolFilterTypePrice)
+	if filter == nil {
+		return nil
+	}
+	return &PriceFilter{
+		MaxPrice: filterString(filter, "maxPrice"),
+		MinPrice: filterString(filter, "minPrice"),
+		TickSize: filterString(filter, "tickSize"),
+	}
+}
+
 // PercentPriceFilter return percent price filter of symbol
 func (s *Symbol) PercentPriceFilter() *PercentPriceFilter {
-	for _, filter := range s.Filters {
-		if filter["filterType"].(string) == string(SymbolFilterTypePercentPrice) {
-			f := &PercentPriceFilter{}
-			if i, ok := filter["multiplierDecimal"]; ok {
-				f.MultiplierDecimal = i.(string)
-			}
-			if i, ok := filter["multiplierUp"]; ok {
-				f.MultiplierUp = i.(string)
-			}
-			if i, ok := filter["multiplierDown"]; ok {
-				f.MultiplierDown = i.(string)
-			}
-			return f
-		}
+	filter := s.filter(SymbolFilterTypePercentPrice)
+	if filter == nil {
+		return nil
+	}
+	return &PercentPriceFilter{
+		MultiplierDecimal: filterString(filter, "multiplierDecimal"),
+		MultiplierUp:      filterString(filter, "multiplierUp"),
+		MultiplierDown:    filterString(filter, "multiplierDown"),
 	}
-	return nil
 }
 
 // MarketLotSizeFilter return market lot size filter of symbol
 func (s *Symbol) MarketLotSizeFilter() *MarketLotSizeFilter {
-	for _, filter := range s.Filters {
-		if filter["filterType"].(string) == string(SymbolFilterTypeMarketLotSize) {
-			f := &MarketLotSizeFilter{}
-			if i, ok := filter["maxQty"]; ok {
-				f.MaxQuantity = i.(string)
-			}
-			if i, ok := filter["minQty"]; ok {
-				f.MinQuantity = i.(string)
-			}
-			if i, ok := filter["stepSize"]; ok {
-				f.StepSize = i.(string)
-			}
-			return f
-		}
+	filter := s.filter(SymbolFilterTypeMarketLotSize)
+	if filter == nil {
+		return nil
+	}
+	return &MarketLotSizeFilter{
+		MaxQuantity: filterString(filter, "maxQty"),
+		MinQuantity: filterString(filter, "minQty"),
+		StepSize:    filterString(filter, "stepSize"),
 	}
-	return nil
 }
 
 // MaxNumOrdersFilter return max num orders filter of symbol
 func (s *Symbol) MaxNumOrdersFilter() *MaxNumOrdersFilter {
-	for _, filter := range s.Filters {
-		if filter["filterType"].(string) == string(SymbolFilterTypeMaxNumOrders) {
-			f := &MaxNumOrdersFilter{}
-			if i, ok := filter["limit"]; ok {
-				if limit, okk := common.ToInt64(i); okk == nil {
-					f.Limit = limit
-				}
-			}
-			return f
-		}
+	filter := s.filter(SymbolFilterTypeMaxNumOrders)
+	if filter == nil {
+		return nil
 	}
-	return nil
+	return &MaxNumOrdersFilter{Limit: filterInt64(filter, "limit")}
 }
 
 // MaxNumAlgoOrdersFilter return max num orders filter of symbol
 func (s *Symbol) MaxNumAlgoOrdersFilter() *MaxNumAlgoOrdersFilter {
-	for _, filter := range s.Filters {
-		if filter["filterType"].(string) == string(SymbolFilterTypeMaxNumAlgoOrders) {
-			f := &MaxNumAlgoOrdersFilter{}
-			if i, ok := filter["limit"]; ok {
-				if limit, okk := common.ToInt64(i); okk == nil {
-					f.Limit = limit
-				}
-			}
-			return f
-		}
+	filter := s.filter(SymbolFilterTypeMaxNumAlgoOrders)
+	if filter == nil {
+		return nil
 	}
-	return nil
+	return &MaxNumAlgoOrdersFilter{Limit: filterInt64(filter, "limit")}
 }
 
 // MinNotionalFilter return min notional filter of symbol
 func (s *Symbol) MinNotionalFilter() *MinNotionalFilter {
-	for _, filter := range s.Filters {
-		if filter["filterType"].(string) == string(SymbolFilterTypeMinNotional) {
-			f := &MinNotionalFilter{}
-			if i, ok := filter["notional"]; ok {
-				f.Notional = i.(string)
-			}
-			return f
-		}
+	filter := s.filter(SymbolFilterTypeMinNotional)
+	if filter == nil {
+		return nil
 	}
-	return nil
+	return &MinNotionalFilter{Notional: filterString(filter, "notional")}
 }
@@ -1,8 +1,12 @@
 package futures
 
 import (
+	"bytes"
+	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/coin-quant/go-aster/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -215,6 +219,131 @@ func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
 	s.assertPercentPriceFilterEqual(ePercentPriceFilter, res.Symbols[0].PercentPriceFilter())
 }
 
+func (s *exchangeInfoServiceTestSuite) TestExchangeInfoSymbolFilters() {
+	data := []byte(`{
+		"timezone": "UTC",
+		"serverTime": 1565613908500,
+		"rateLimits": [],
+		"exchangeFilters": [],
+		"symbols": [
+			{
+				"symbol": "BTCUSDT",
+				"status": "TRADING",
+				"quoteAsset": "USDT",
+				"filters": [
+					{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+					{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+					{"filterType": "MARKET_LOT_SIZE", "minQty": "0.001", "maxQty": "120", "stepSize": "0.001"},
+					{"filterType": "MAX_NUM_ORDERS", "limit": 200},
+					{"filterType": "MAX_NUM_ALGO_ORDERS", "limit": 10},
+					{"filterType": "MIN_NOTIONAL", "notional": "100"},
+					{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": 4}
+				]
+			},
+			{
+				"symbol": "ASTERUSDT",
+				"status": "TRADING",
+				"quoteAsset": "USDT",
+				"filters": [
+					{"minPrice": "0.0001"},
+					{"filterType": "LOT_SIZE", "minQty": 1, "maxQty": 5000000, "stepSize": 1}
+				]
+			}
+		]
+	}`)
+	s.mockDoOnce(data, nil)
+
+	res, err := s.client.NewExchangeInfoService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Nil(res.Symbol("ETHUSDT"))
+
+	btc := res.Symbol("BTCUSDT")
+	r.NotNil(btc)
+	s.assertPriceFilterEqual(&PriceFilter{MaxPrice: "4529764", MinPrice: "556.80", TickSize: "0.10"}, btc.PriceFilter())
+	s.assertLotSizeFilterEqual(&LotSizeFilter{MaxQuantity: "1000", MinQuantity: "0.001", StepSize: "0.001"}, btc.LotSizeFilter())
+	s.assertMarketLotSizeFilterEqual(&MarketLotSizeFilter{MaxQuantity: "120", MinQuantity: "0.001", StepSize: "0.001"}, btc.MarketLotSizeFilter())
+	s.assertMaxNumOrdersFilterEqual(&MaxNumOrdersFilter{Limit: 200}, btc.MaxNumOrdersFilter())
+	s.assertMaxNumAlgoOrdersFilterEqual(&MaxNumAlgoOrdersFilter{Limit: 10}, btc.MaxNumAlgoOrdersFilter())
+	s.assertMinNotionalFilterEqual(&MinNotionalFilter{Notional: "100"}, btc.MinNotionalFilter())
+	s.assertPercentPriceFilterEqual(&PercentPriceFilter{MultiplierDecimal: "4", MultiplierUp: "1.0500", MultiplierDown: "0.9500"}, btc.PercentPriceFilter())
+
+	// a filter without filterType is skipped and numbers are formatted as strings
+	aster := res.Symbol("ASTERUSDT")
+	r.NotNil(aster)
+	r.Nil(aster.PriceFilter())
+	r.Nil(aster.MinNotionalFilter())
+	r.Nil(aster.MaxNumOrdersFilter())
+	s.assertLotSizeFilterEqual(&LotSizeFilter{MaxQuantity: "5000000", MinQuantity: "1", StepSize: "1"}, aster.LotSizeFilter())
+}
+
+func (s *exchangeInfoServiceTestSuite) TestTradableSymbols() {
+	data := []byte(`{
+		"symbols": [
+			{"symbol": "BTCUSDT", "status": "TRADING", "quoteAsset": "USDT"},
+			{"symbol": "ETHUSDT", "status": "TRADING", "quoteAsset": "USDT"},
+			{"symbol": "LUNAUSDT", "status": "SETTLING", "quoteAsset": "USDT"},
+			{"symbol": "NEWUSDT", "status": "PENDING_TRADING", "quoteAsset": "USDT"},
+			{"symbol": "BTCUSD1", "status": "TRADING", "quoteAsset": "USD1"},
+			{"symbol": "SOLUSDT", "status": "TRADING", "quoteAsset": "USDT"}
+		]
+	}`)
+	s.mockDoOnce(data, nil)
+
+	res, err := s.client.NewExchangeInfoService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]string{"BTCUSDT", "ETHUSDT", "SOLUSDT"}, res.TradableSymbols("USDT"))
+	r.Equal([]string{"BTCUSD1"}, res.TradableSymbols("USD1"))
+	r.Empty(res.TradableSymbols("BUSD"))
+
+	r.True(res.IsTradable("ETHUSDT"))
+	r.True(res.IsTradable("BTCUSD1"))
+	r.False(res.IsTradable("LUNAUSDT"))
+	r.False(res.IsTradable("NEWUSDT"))
+	r.False(res.IsTradable("XRPUSDT"))
+}
+
+func (s *exchangeInfoServiceTestSuite) TestExchangeInfoSymbolFilter() {
+	data := []byte(`{
+		"timezone": "UTC",
+		"serverTime": 1712730000000,
+		"rateLimits": [{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 2400}],
+		"symbols": [
+			{"symbol": "BTCUSDT", "status": "TRADING", "quoteAsset": "USDT", "filters": [{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"}]},
+			{"symbol": "ETHUSDT", "status": "TRADING", "quoteAsset": "USDT"},
+			{"symbol": "BTCUSD1", "status": "TRADING", "quoteAsset": "USD1"}
+		],
+		"exchangeFilters": []
+	}`)
+	s.mockDoOnce(data, nil)
+
+	res, err := s.client.NewExchangeInfoService().SymbolFilter(func(symbol *Symbol) bool {
+		return symbol.Symbol == "BTCUSDT" || symbol.QuoteAsset == "USD1"
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("UTC", res.Timezone)
+	r.Equal(int64(1712730000000), res.ServerTime)
+	r.Len(res.RateLimits, 1)
+	r.Len(res.Symbols, 2)
+	r.Equal("BTCUSDT", res.Symbols[0].Symbol)
+	r.Equal("0.001", res.Symbol("BTCUSDT").LotSizeFilter().StepSize)
+	r.Equal("BTCUSD1", res.Symbols[1].Symbol)
+	r.Nil(res.Symbol("ETHUSDT"))
+}
+
+func (s *exchangeInfoServiceTestSuite) TestExchangeInfoSymbolFilterError() {
+	s.mockDoOnce([]byte(`{"code": -1003, "msg": "Too many requests"}`), nil, http.StatusTooManyRequests)
+
+	_, err := s.client.NewExchangeInfoService().SymbolFilter(func(*Symbol) bool { return true }).Do(newContext())
+	s.r().True(common.IsRateLimited(err))
+
+	s.mockDoOnce([]byte(`{"symbols": {}}`), nil)
+	_, err = s.client.NewExchangeInfoService().SymbolFilter(func(*Symbol) bool { return true }).Do(newContext())
+	s.r().EqualError(err, "unexpected { for symbols")
+}
+
 func (s *exchangeInfoServiceTestSuite) assertExchangeInfoEqual(e, a *ExchangeInfo) {
 	r := s.r()
 
@@ -337,3 +466,47 @@ func (s *exchangeInfoServiceTestSuite) assertMaxNumAlgoOrdersFilterEqual(e, a *M
 	r := s.r()
 	r.Equal(e.Limit, a.Limit, "Limit")
 }
+
+func exchangeInfoBenchmarkData(n int) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(`{"timezone": "UTC", "serverTime": 1712730000000, "symbols": [`)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		fmt.Fprintf(&buf, `{"symbol": "SYM%dUSDT", "status": "TRADING", "quoteAsset": "USDT", "orderType": ["LIMIT", "MARKET"],
+			"filters": [{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+			{"filterType": "PRICE_FILTER", "minPrice": "0.1", "maxPrice": "100000", "tickSize": "0.1"}]}`, i)
+	}
+	buf.WriteString(`]}`)
+	return buf.Bytes()
+}
+
+func BenchmarkExchangeInfoDecode(b *testing.B) {
+	data := exchangeInfoBenchmarkData(1000)
+	c := NewClient("user", "signer", testPrivateKey)
+	b.Run("full", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			res := new(ExchangeInfo)
+			if err := c.unmarshal(data, res); err != nil {
+				b.Fatal(err)
+			}
+			if res.Symbol("SYM7USDT") == nil {
+				b.Fatal("symbol not found")
+			}
+		}
+	})
+	b.Run("stream", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			res, err := c.decodeExchangeInfo(bytes.NewReader(data), func(s *Symbol) bool { return s.Symbol == "SYM7USDT" })
+			if err != nil {
+				b.Fatal(err)
+			}
+			if res.Symbol("SYM7USDT") == nil {
+				b.Fatal("symbol not found")
+			}
+		}
+	})
+}
@@ -2,9 +2,10 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 )
 
 // FeeBurnService set fee burn info
@@ -13,11 +14,19 @@ type FeeBurnService struct {
 	feeBurn string
 }
 
+// FeeBurn set feeBurn: true to pay the fees with the discount asset, false otherwise
+func (f *FeeBurnService) FeeBurn(feeBurn bool) *FeeBurnService {
+	f.feeBurn = strconv.FormatBool(feeBurn)
+	return f
+}
+
+// Enable is similar to FeeBurn(true)
 func (f *FeeBurnService) Enable() *FeeBurnService {
 	f.feeBurn = "true"
 	return f
 }
 
+// Disable is similar to FeeBurn(false)
 func (f *FeeBurnService) Disable() *FeeBurnService {
 	f.feeBurn = "false"
 	return f
@@ -25,6 +34,9 @@ func (f *FeeBurnService) Disable() *FeeBurnService {
 
 // Do send request
 func (s *FeeBurnService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	if s.feeBurn == "" {
+		return errors.New("feeBurn is required, see FeeBurn")
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/feeBurn",
@@ -38,17 +50,15 @@ func (s *FeeBurnService) Do(ctx context.Context, opts ...RequestOption) (err err
 	if err != nil {
 		return err
 	}
-	j, err := newJSON(data)
-	if err != nil {
+	res := new(struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	})
+	if err = s.c.unmarshal(data, res); err != nil {
 		return err
 	}
-	msg, err := j.Get("msg").String()
-	if err != nil {
-		return err
-	}
-	if msg != "success" {
-		code, _ := j.Get("code").Int()
-		return fmt.Errorf("code: %d, msg: %s", code, msg)
+	if res.Msg != "success" {
+		return fmt.Errorf("code: %d, msg: %s", res.Code, res.Msg)
 	}
 	return nil
 }
@@ -59,7 +69,7 @@ type GetFeeBurnService struct {
 }
 
 // Do send request
-func (s *GetFeeBurnService) Do(ctx context.Context, opts ...RequestOption) (res *FeeBurn, err error) {
+func (s *GetFeeBurnService) Do(ctx context.Context, opts ...RequestOption) (res *FeeBurnStatus, err error) {
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/feeBurn",
@@ -69,8 +79,8 @@ func (s *GetFeeBurnService) Do(ctx context.Context, opts ...RequestOption) (res
 	if err != nil {
 		return nil, err
 	}
-	res = new(FeeBurn)
-	err = json.Unmarshal(data, res)
+	res = new(FeeBurnStatus)
+	err = s.c.unmarshal(data, res)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +88,12 @@ func (s *GetFeeBurnService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
-type FeeBurn struct {
+// FeeBurnStatus define the fee burn setting of the account
+type FeeBurnStatus struct {
 	FeeBurn bool `json:"feeBurn"`
 }
+
+// FeeBurn is the former name of FeeBurnStatus.
+//
+// Deprecated: use FeeBurnStatus.
+type FeeBurn = FeeBurnStatus
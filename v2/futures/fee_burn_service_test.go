@@ -1,6 +1,7 @@
 package futures
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -53,3 +54,31 @@ func (s *feeburnServiceTestSuite) TestFeeBurnDisable() {
 	err := s.client.NewFeeBurnService().Disable().Do(newContext())
 	s.r().NoError(err)
 }
+
+func (s *feeburnServiceTestSuite) TestGetFeeBurnStatus() {
+	s.mockDoOnce([]byte(`{"feeBurn": false}`), nil)
+
+	res, err := s.client.NewGetFeeBurnService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&FeeBurnStatus{FeeBurn: false}, res)
+}
+
+func (s *feeburnServiceTestSuite) TestFeeBurnOn() {
+	for _, on := range []bool{true, false} {
+		s.SetupTest()
+		s.mockDoOnce([]byte(`{"code": 200, "msg": "success"}`), nil)
+		s.assertReq(func(r *request) {
+			e := newSignedRequest().setFormParam("feeBurn", strconv.FormatBool(on))
+			s.assertRequestEqual(e, r)
+		})
+
+		s.r().NoError(s.client.NewFeeBurnService().FeeBurn(on).Do(newContext()))
+		s.assertDo()
+	}
+}
+
+func (s *feeburnServiceTestSuite) TestFeeBurnRequired() {
+	err := s.client.NewFeeBurnService().Do(newContext())
+	s.r().EqualError(err, "feeBurn is required, see FeeBurn")
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
@@ -0,0 +1,19 @@
+package futures
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// EstimateFundingPayment estimate the funding fee of a position at the next funding time
+//
+//	payment = -positionAmt * markPrice * fundingRate
+//
+// The result has the sign of the FUNDING_FEE income: with a positive funding rate, longs pay
+// and get a negative payment while shorts receive a positive one.
+func EstimateFundingPayment(pos *PositionRisk, fundingRate, markPrice decimal.Decimal) (decimal.Decimal, error) {
+	amount, err := parseDecimal("positionAmt", pos.PositionAmt)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return amount.Mul(markPrice).Mul(fundingRate).Neg(), nil
+}
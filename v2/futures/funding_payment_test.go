@@ -0,0 +1,35 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateFundingPayment(t *testing.T) {
+	tests := []struct {
+		name        string
+		positionAmt string
+		fundingRate string
+		markPrice   string
+		want        string
+	}{
+		{"long pays", "0.5", "0.0001", "60000", "-3"},
+		{"short receives", "-2", "0.0001", "3000", "0.6"},
+		{"long receives on negative rate", "10", "-0.0005", "2", "0.01"},
+		{"short pays on negative rate", "-1", "-0.00025", "40000", "-10"},
+		{"empty position", "0", "0.0001", "60000", "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payment, err := EstimateFundingPayment(&PositionRisk{Symbol: "BTCUSDT", PositionAmt: tt.positionAmt},
+				decimal.RequireFromString(tt.fundingRate), decimal.RequireFromString(tt.markPrice))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, payment.String())
+		})
+	}
+
+	_, err := EstimateFundingPayment(&PositionRisk{PositionAmt: "abc"}, decimal.Zero, decimal.Zero)
+	require.Error(t, err)
+}
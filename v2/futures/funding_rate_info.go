@@ -2,7 +2,6 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 
 	"github.com/coin-quant/go-aster/v2/common"
@@ -26,7 +25,7 @@ func (s *FundingRateInfoService) Do(ctx context.Context, opts ...RequestOption)
 		return []*FundingRateInfo{}, err
 	}
 	res = make([]*FundingRateInfo, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*FundingRateInfo{}, err
 	}
@@ -0,0 +1,76 @@
+package futures
+
+import (
+	"context"
+	"time"
+)
+
+// FundingRateIterator page forward through the funding rate history of a symbol, oldest first
+type FundingRateIterator struct {
+	c         *Client
+	symbol    string
+	startTime int64
+	endTime   int64
+	limit     int
+
+	started bool
+	cursor  int64
+	pager[*FundingRate]
+}
+
+// NewFundingRateIterator init a funding rate history iterator for symbol
+func (c *Client) NewFundingRateIterator(symbol string) *FundingRateIterator {
+	it := &FundingRateIterator{c: c, symbol: symbol, limit: 1000}
+	it.nextPage = it.fetch
+	return it
+}
+
+// StartTime set startTime in ms
+func (it *FundingRateIterator) StartTime(startTime int64) *FundingRateIterator {
+	it.startTime = startTime
+	return it
+}
+
+// EndTime set endTime in ms, default to now
+func (it *FundingRateIterator) EndTime(endTime int64) *FundingRateIterator {
+	it.endTime = endTime
+	return it
+}
+
+// Limit set page size, the API returns at most 1000 rows
+func (it *FundingRateIterator) Limit(limit int) *FundingRateIterator {
+	it.limit = limit
+	return it
+}
+
+// FundingRate return the current funding rate
+func (it *FundingRateIterator) FundingRate() *FundingRate {
+	return it.current
+}
+
+func (it *FundingRateIterator) fetch(ctx context.Context) (page []*FundingRate, last bool, err error) {
+	if !it.started {
+		it.started = true
+		if it.endTime == 0 {
+			it.endTime = time.Now().UnixMilli()
+		}
+		it.cursor = it.startTime
+	}
+	rates, err := it.c.NewFundingRateService().Symbol(it.symbol).
+		StartTime(it.cursor).EndTime(it.endTime).Limit(it.limit).Do(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, r := range rates {
+		// skip rows already returned by the previous page
+		if r.FundingTime < it.cursor {
+			continue
+		}
+		if r.FundingTime > it.endTime {
+			break
+		}
+		page = append(page, r)
+		it.cursor = r.FundingTime + 1
+	}
+	return page, len(rates) < it.limit || len(page) == 0 || it.cursor > it.endTime, nil
+}
@@ -0,0 +1,45 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type fundingRateIteratorTestSuite struct {
+	baseTestSuite
+}
+
+func TestFundingRateIterator(t *testing.T) {
+	suite.Run(t, new(fundingRateIteratorTestSuite))
+}
+
+const fundingRateRow = `{"symbol": "BTCUSDT", "fundingRate": "0.0001", "fundingTime": %d, "markPrice": "60000"}`
+
+func fundingTime(r *FundingRate) int64 {
+	return r.FundingTime
+}
+
+func (s *fundingRateIteratorTestSuite) TestPages() {
+	s.mockDoOnce(pageData(fundingRateRow, 100, 200, 300), nil)
+	s.mockDoOnce(pageData(fundingRateRow, 300, 400, 500), nil)
+	s.mockDoOnce(pageData(fundingRateRow, 600), nil)
+
+	it := s.client.NewFundingRateIterator("BTCUSDT").StartTime(100).EndTime(1000).Limit(3)
+	s.r().Equal([]int64{100, 200, 300, 400, 500, 600}, collect(&it.pager, fundingTime))
+	s.r().NoError(it.Err())
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+	s.r().Nil(it.FundingRate())
+
+	rate, err := (&FundingRate{FundingRate: "-0.0375"}).FundingRateDecimal()
+	s.r().NoError(err)
+	s.r().Equal("-0.0375", rate.String())
+}
+
+func (s *fundingRateIteratorTestSuite) TestEndTime() {
+	s.mockDoOnce(pageData(fundingRateRow, 100, 200), nil)
+
+	it := s.client.NewFundingRateIterator("BTCUSDT").StartTime(100).EndTime(200).Limit(2)
+	s.r().Equal([]int64{100, 200}, collect(&it.pager, fundingTime))
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
@@ -0,0 +1,112 @@
+package futures
+
+import (
+	"context"
+)
+
+// HistoricalTradesIterator page through the trade history of a symbol by trade id, oldest first
+// or newest first when Backward is set
+type HistoricalTradesIterator struct {
+	c        *Client
+	symbol   string
+	fromID   *int64
+	limit    int
+	backward bool
+
+	started bool
+	cursor  int64
+	pager[*Trade]
+}
+
+// NewHistoricalTradesIterator init a historical trades iterator for symbol, it starts from the most recent
+// trades unless FromID is set
+func (c *Client) NewHistoricalTradesIterator(symbol string) *HistoricalTradesIterator {
+	it := &HistoricalTradesIterator{c: c, symbol: symbol, limit: 500}
+	it.nextPage = it.fetch
+	return it
+}
+
+// FromID set the id of the first trade returned
+func (it *HistoricalTradesIterator) FromID(fromID int64) *HistoricalTradesIterator {
+	it.fromID = &fromID
+	return it
+}
+
+// Limit set page size, the API returns at most 500 rows
+func (it *HistoricalTradesIterator) Limit(limit int) *HistoricalTradesIterator {
+	it.limit = limit
+	return it
+}
+
+// Backward walk toward older trades, newest first
+func (it *HistoricalTradesIterator) Backward() *HistoricalTradesIterator {
+	it.backward = true
+	return it
+}
+
+// Trade return the current trade
+func (it *HistoricalTradesIterator) Trade() *Trade {
+	return it.current
+}
+
+func (it *HistoricalTradesIterator) fetch(ctx context.Context) (page []*Trade, last bool, err error) {
+	service := it.c.NewHistoricalTradesService().Symbol(it.symbol).Limit(it.limit)
+	if it.backward {
+		return it.fetchBackward(ctx, service)
+	}
+	if it.started {
+		service.FromID(it.cursor)
+	} else if it.fromID != nil {
+		service.FromID(*it.fromID)
+	}
+	trades, err := service.Do(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	first := !it.started
+	it.started = true
+	for _, t := range trades {
+		// skip rows already returned by the previous page
+		if !first && t.ID < it.cursor {
+			continue
+		}
+		page = append(page, t)
+		it.cursor = t.ID + 1
+	}
+	return page, len(trades) < it.limit || len(page) == 0, nil
+}
+
+// fetchBackward fetch the page ending at the cursor, the highest trade id not returned yet
+func (it *HistoricalTradesIterator) fetchBackward(ctx context.Context, service *HistoricalTradesService) (page []*Trade, last bool, err error) {
+	if !it.started && it.fromID != nil {
+		it.started = true
+		it.cursor = *it.fromID
+	}
+	if it.started {
+		from := it.cursor - int64(it.limit) + 1
+		if from < 0 {
+			from = 0
+		}
+		service.FromID(from)
+	}
+	trades, err := service.Do(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	first := !it.started
+	it.started = true
+	minID := int64(-1)
+	for i := len(trades) - 1; i >= 0; i-- {
+		t := trades[i]
+		if !first && t.ID > it.cursor {
+			continue
+		}
+		page = append(page, t)
+		minID = t.ID
+	}
+	if minID <= 0 {
+		return page, true, nil
+	}
+	it.cursor = minID - 1
+	return page, false, nil
+}
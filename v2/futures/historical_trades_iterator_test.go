@@ -0,0 +1,64 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type historicalTradesIteratorTestSuite struct {
+	baseTestSuite
+	fromIDs []string
+}
+
+func TestHistoricalTradesIterator(t *testing.T) {
+	suite.Run(t, new(historicalTradesIteratorTestSuite))
+}
+
+func (s *historicalTradesIteratorTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.fromIDs = nil
+	s.assertReq(func(r *request) {
+		s.r().NotEmpty(r.query.Get(signatureKey))
+		s.fromIDs = append(s.fromIDs, r.query.Get("fromId"))
+	})
+}
+
+const tradeRow = `{"id": %[1]d, "price": "100", "qty": "1", "quoteQty": "100", "time": %[1]d}`
+
+func tradeID(t *Trade) int64 {
+	return t.ID
+}
+
+func (s *historicalTradesIteratorTestSuite) TestForward() {
+	s.mockDoOnce(pageData(tradeRow, 10, 11, 12), nil)
+	s.mockDoOnce(pageData(tradeRow, 13, 14, 15), nil)
+	s.mockDoOnce(pageData(tradeRow, 16, 17), nil)
+
+	it := s.client.NewHistoricalTradesIterator("BTCUSDT").FromID(10).Limit(3)
+	s.r().Equal([]int64{10, 11, 12, 13, 14, 15, 16, 17}, collect(&it.pager, tradeID))
+	s.r().NoError(it.Err())
+	s.r().Nil(it.Trade())
+	s.r().Equal([]string{"10", "13", "16"}, s.fromIDs)
+}
+
+func (s *historicalTradesIteratorTestSuite) TestBackward() {
+	// the most recent trades first, then pages ending before the oldest trade returned
+	s.mockDoOnce(pageData(tradeRow, 5, 6, 7), nil)
+	s.mockDoOnce(pageData(tradeRow, 2, 3, 4), nil)
+	s.mockDoOnce(pageData(tradeRow, 0, 1, 2), nil)
+
+	it := s.client.NewHistoricalTradesIterator("BTCUSDT").Limit(3).Backward()
+	s.r().Equal([]int64{7, 6, 5, 4, 3, 2, 1, 0}, collect(&it.pager, tradeID))
+	s.r().NoError(it.Err())
+	s.r().Equal([]string{"", "2", "0"}, s.fromIDs)
+}
+
+func (s *historicalTradesIteratorTestSuite) TestBackwardFromID() {
+	s.mockDoOnce(pageData(tradeRow, 98, 99, 100), nil)
+	s.mockDoOnce([]byte(`[]`), nil)
+
+	it := s.client.NewHistoricalTradesIterator("BTCUSDT").FromID(100).Limit(3).Backward()
+	s.r().Equal([]int64{100, 99, 98}, collect(&it.pager, tradeID))
+	s.r().Equal([]string{"98", "95"}, s.fromIDs)
+}
@@ -2,15 +2,17 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"net/http"
+
+	"github.com/shopspring/decimal"
 )
 
 // GetIncomeHistoryService get position margin history service
 type GetIncomeHistoryService struct {
 	c          *Client
 	symbol     string
-	incomeType string
+	incomeType IncomeType
 	startTime  *int64
 	endTime    *int64
 	limit      *int64
@@ -24,6 +26,12 @@ func (s *GetIncomeHistoryService) Symbol(symbol string) *GetIncomeHistoryService
 
 // IncomeType set income type
 func (s *GetIncomeHistoryService) IncomeType(incomeType string) *GetIncomeHistoryService {
+	s.incomeType = IncomeType(incomeType)
+	return s
+}
+
+// Type set income type
+func (s *GetIncomeHistoryService) Type(incomeType IncomeType) *GetIncomeHistoryService {
 	s.incomeType = incomeType
 	return s
 }
@@ -48,6 +56,9 @@ func (s *GetIncomeHistoryService) Limit(limit int64) *GetIncomeHistoryService {
 
 // Do send request
 func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*IncomeHistory, err error) {
+	if err = validateIncomeType(s.incomeType); err != nil {
+		return nil, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/fapi/v1/income",
@@ -72,21 +83,37 @@ func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption)
 		return nil, err
 	}
 	res = make([]*IncomeHistory, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
+// validateIncomeType check incomeType is empty or one of the defined IncomeType values
+func validateIncomeType(incomeType IncomeType) error {
+	switch incomeType {
+	case "", IncomeTypeTransfer, IncomeTypeWelcomeBonus, IncomeTypeRealizedPnl, IncomeTypeFundingFee,
+		IncomeTypeCommission, IncomeTypeInsuranceClear, IncomeTypeReferralKickback, IncomeTypeCommissionRebate,
+		IncomeTypeApiRebate, IncomeTypeMarketMerchantReturnReward:
+		return nil
+	}
+	return fmt.Errorf("invalid incomeType %q", incomeType)
+}
+
 // IncomeHistory define position margin history info
 type IncomeHistory struct {
-	Asset      string `json:"asset"`
-	Income     string `json:"income"`
-	IncomeType string `json:"incomeType"`
-	Info       string `json:"info"`
-	Symbol     string `json:"symbol"`
-	Time       int64  `json:"time"`
-	TranID     int64  `json:"tranId"`
-	TradeID    string `json:"tradeId"`
+	Asset      string     `json:"asset"`
+	Income     string     `json:"income"`
+	IncomeType IncomeType `json:"incomeType"`
+	Info       string     `json:"info"`
+	Symbol     string     `json:"symbol"`
+	Time       int64      `json:"time"`
+	TranID     int64      `json:"tranId"`
+	TradeID    string     `json:"tradeId"`
+}
+
+// IncomeDecimal return the income as a decimal, negative for a fee paid
+func (h *IncomeHistory) IncomeDecimal() (decimal.Decimal, error) {
+	return parseDecimal("income", h.Income)
 }
@@ -72,3 +72,37 @@ func (s *incomeHistoryServiceTestSuite) assertOrderEqual(e, a *IncomeHistory) {
 	r.Equal(e.TranID, a.TranID, "TranID")
 	r.Equal(e.TradeID, a.TradeID, "TradeID")
 }
+
+func (s *incomeHistoryServiceTestSuite) TestIncomeHistoryMixedTypes() {
+	data := []byte(`[
+		{"symbol": "BTCUSDT", "incomeType": "REALIZED_PNL", "income": "12.5", "asset": "USDT", "info": "", "time": 1570636800000, "tranId": 1, "tradeId": "10"},
+		{"symbol": "BTCUSDT", "incomeType": "FUNDING_FEE", "income": "-0.0321", "asset": "USDT", "info": "", "time": 1570636800001, "tranId": 2, "tradeId": ""},
+		{"symbol": "", "incomeType": "TRANSFER", "income": "100", "asset": "USDT", "info": "TRANSFER", "time": 1570636800002, "tranId": 3, "tradeId": ""}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	incomes, err := s.client.NewGetIncomeHistoryService().Symbol("BTCUSDT").
+		Type(IncomeTypeFundingFee).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(incomes, 3)
+	r.Equal(IncomeTypeRealizedPnl, incomes[0].IncomeType)
+	r.Equal(IncomeTypeFundingFee, incomes[1].IncomeType)
+	r.Equal(IncomeTypeTransfer, incomes[2].IncomeType)
+	income, err := incomes[1].IncomeDecimal()
+	r.NoError(err)
+	r.Equal("-0.0321", income.String())
+	income, err = incomes[2].IncomeDecimal()
+	r.NoError(err)
+	r.Equal("100", income.String())
+}
+
+func (s *incomeHistoryServiceTestSuite) TestIncomeHistoryInvalidType() {
+	_, err := s.client.NewGetIncomeHistoryService().Type("FEE").Do(newContext())
+	s.r().EqualError(err, `invalid incomeType "FEE"`)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+
+	_, err = s.client.NewGetIncomeHistoryService().IncomeType("realized_pnl").Do(newContext())
+	s.r().EqualError(err, `invalid incomeType "realized_pnl"`)
+}
@@ -0,0 +1,108 @@
+package futures
+
+import (
+	"context"
+	"time"
+)
+
+// incomeKey identify an income row, rows of the same trade share the tranId
+type incomeKey struct {
+	tranID     int64
+	incomeType IncomeType
+	symbol     string
+	asset      string
+}
+
+// IncomeIterator page forward through the income history, oldest first
+type IncomeIterator struct {
+	c          *Client
+	symbol     string
+	incomeType IncomeType
+	startTime  int64
+	endTime    int64
+	limit      int
+
+	started bool
+	cursor  int64
+	seen    map[incomeKey]bool
+	pager[*IncomeHistory]
+}
+
+// NewIncomeIterator init an income history iterator over all the symbols and income types
+func (c *Client) NewIncomeIterator() *IncomeIterator {
+	it := &IncomeIterator{c: c, limit: 1000}
+	it.nextPage = it.fetch
+	return it
+}
+
+// Symbol restrict the iteration to symbol
+func (it *IncomeIterator) Symbol(symbol string) *IncomeIterator {
+	it.symbol = symbol
+	return it
+}
+
+// Type restrict the iteration to incomeType
+func (it *IncomeIterator) Type(incomeType IncomeType) *IncomeIterator {
+	it.incomeType = incomeType
+	return it
+}
+
+// StartTime set startTime in ms
+func (it *IncomeIterator) StartTime(startTime int64) *IncomeIterator {
+	it.startTime = startTime
+	return it
+}
+
+// EndTime set endTime in ms, default to now
+func (it *IncomeIterator) EndTime(endTime int64) *IncomeIterator {
+	it.endTime = endTime
+	return it
+}
+
+// Limit set page size, the API returns at most 1000 rows
+func (it *IncomeIterator) Limit(limit int) *IncomeIterator {
+	it.limit = limit
+	return it
+}
+
+// Income return the current income
+func (it *IncomeIterator) Income() *IncomeHistory {
+	return it.current
+}
+
+func (it *IncomeIterator) fetch(ctx context.Context) (page []*IncomeHistory, last bool, err error) {
+	if !it.started {
+		it.started = true
+		if it.endTime == 0 {
+			it.endTime = time.Now().UnixMilli()
+		}
+		it.cursor = it.startTime
+		it.seen = map[incomeKey]bool{}
+	}
+	s := it.c.NewGetIncomeHistoryService().Symbol(it.symbol).Type(it.incomeType).
+		StartTime(it.cursor).EndTime(it.endTime).Limit(int64(it.limit))
+	incomes, err := s.Do(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, income := range incomes {
+		if income.Time < it.cursor {
+			continue
+		}
+		if income.Time > it.endTime {
+			break
+		}
+		// several rows can share a timestamp, the next page restarts at the last one
+		// so the rows of that millisecond already returned are skipped
+		key := incomeKey{tranID: income.TranID, incomeType: income.IncomeType, symbol: income.Symbol, asset: income.Asset}
+		if income.Time > it.cursor {
+			it.cursor = income.Time
+			it.seen = map[incomeKey]bool{}
+		} else if it.seen[key] {
+			continue
+		}
+		it.seen[key] = true
+		page = append(page, income)
+	}
+	return page, len(incomes) < it.limit || len(page) == 0, nil
+}
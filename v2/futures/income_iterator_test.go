@@ -0,0 +1,41 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type incomeIteratorTestSuite struct {
+	baseTestSuite
+}
+
+func TestIncomeIterator(t *testing.T) {
+	suite.Run(t, new(incomeIteratorTestSuite))
+}
+
+func (s *incomeIteratorTestSuite) TestPages() {
+	// the second page restarts at time 200, the rows of that millisecond already returned are skipped
+	s.mockDoOnce([]byte(`[
+		{"symbol": "BTCUSDT", "incomeType": "REALIZED_PNL", "income": "10", "asset": "USDT", "time": 100, "tranId": 1},
+		{"symbol": "BTCUSDT", "incomeType": "REALIZED_PNL", "income": "5", "asset": "USDT", "time": 200, "tranId": 2},
+		{"symbol": "BTCUSDT", "incomeType": "COMMISSION", "income": "-0.5", "asset": "USDT", "time": 200, "tranId": 2}
+	]`), nil)
+	s.mockDoOnce([]byte(`[
+		{"symbol": "BTCUSDT", "incomeType": "REALIZED_PNL", "income": "5", "asset": "USDT", "time": 200, "tranId": 2},
+		{"symbol": "BTCUSDT", "incomeType": "COMMISSION", "income": "-0.5", "asset": "USDT", "time": 200, "tranId": 2},
+		{"symbol": "BTCUSDT", "incomeType": "FUNDING_FEE", "income": "-1", "asset": "USDT", "time": 200, "tranId": 3}
+	]`), nil)
+	s.mockDoOnce([]byte(`[
+		{"symbol": "BTCUSDT", "incomeType": "FUNDING_FEE", "income": "-1", "asset": "USDT", "time": 200, "tranId": 3},
+		{"symbol": "BTCUSDT", "incomeType": "REALIZED_PNL", "income": "7", "asset": "USDT", "time": 300, "tranId": 4}
+	]`), nil)
+
+	it := s.client.NewIncomeIterator().Symbol("BTCUSDT").StartTime(100).EndTime(1000).Limit(3)
+	tranIDs := collect(&it.pager, func(income *IncomeHistory) int64 { return income.TranID })
+	r := s.r()
+	r.NoError(it.Err())
+	r.Equal([]int64{1, 2, 2, 3, 4}, tranIDs)
+	r.Nil(it.Income())
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
@@ -2,7 +2,6 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 )
 
@@ -44,7 +43,7 @@ func (s *IndexInfoService) Do(ctx context.Context, opts ...RequestOption) (res [
 		return nil, err
 	}
 	res = make([]*IndexInfo, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,205 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrKlineGap is returned by KlineBackfill when klines are still missing after being fetched again
+var ErrKlineGap = errors.New("kline series has a gap")
+
+// klineIntervals map the kline intervals of fixed length to their duration
+var klineIntervals = map[string]time.Duration{
+	"1m":  time.Minute,
+	"3m":  3 * time.Minute,
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"30m": 30 * time.Minute,
+	"1h":  time.Hour,
+	"2h":  2 * time.Hour,
+	"4h":  4 * time.Hour,
+	"6h":  6 * time.Hour,
+	"8h":  8 * time.Hour,
+	"12h": 12 * time.Hour,
+	"1d":  24 * time.Hour,
+	"3d":  3 * 24 * time.Hour,
+	"1w":  7 * 24 * time.Hour,
+}
+
+// KlineBackfill build a contiguous, deduplicated series of closed klines of a symbol, oldest first.
+// Do page forward from the start time to now, Stream then append the klines closed in real time.
+type KlineBackfill struct {
+	c         *Client
+	symbol    string
+	interval  string
+	startTime int64
+	limit     int
+
+	mu     sync.Mutex
+	klines []*Kline
+	seen   map[int64]bool
+}
+
+// NewKlineBackfill init a backfill of the symbol klines of interval from startTime in ms,
+// interval must have a fixed length, 1M is not supported
+func (c *Client) NewKlineBackfill(symbol, interval string, startTime int64) *KlineBackfill {
+	return &KlineBackfill{c: c, symbol: symbol, interval: interval, startTime: startTime, limit: 1500, seen: map[int64]bool{}}
+}
+
+// Limit set page size, the API returns at most 1500 klines
+func (b *KlineBackfill) Limit(limit int) *KlineBackfill {
+	b.limit = limit
+	return b
+}
+
+// Klines return a copy of the series
+func (b *KlineBackfill) Klines() []*Kline {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*Kline(nil), b.klines...)
+}
+
+// Do fetch the klines closed since the start time, or since the last kline of the series when called again,
+// fetch again the ranges missing from the pages and return the series
+func (b *KlineBackfill) Do(ctx context.Context) ([]*Kline, error) {
+	step, err := b.step()
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now().UnixMilli()
+	cursor := b.startTime
+	if n := len(b.klines); n > 0 {
+		cursor = b.klines[n-1].OpenTime + step
+	}
+	for cursor < now {
+		page, err := b.fetch(ctx, cursor, now)
+		if err != nil {
+			return nil, err
+		}
+		b.merge(page, now)
+		if len(page) < b.limit || page[len(page)-1].OpenTime+step <= cursor {
+			break
+		}
+		cursor = page[len(page)-1].OpenTime + step
+	}
+	if err := b.fillGaps(ctx, step, now); err != nil {
+		return nil, err
+	}
+	return append([]*Kline(nil), b.klines...), nil
+}
+
+// Stream append the klines closed in real time to the series through WsKlineServe on the endpoint of the client,
+// handler is called with each kline appended. A kline arriving after a gap trigger a REST fetch of the missing
+// ones, from the websocket read loop.
+func (b *KlineBackfill) Stream(handler func(kline *Kline), errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	step, err := b.step()
+	if err != nil {
+		return nil, nil, err
+	}
+	return WsKlineServe(b.symbol, b.interval, func(event *WsKlineEvent) {
+		if !event.Kline.IsFinal {
+			return
+		}
+		kline := klineFromWs(&event.Kline)
+		b.mu.Lock()
+		n := len(b.klines)
+		if n > 0 && kline.OpenTime <= b.klines[n-1].OpenTime {
+			b.mu.Unlock()
+			return
+		}
+		var added []*Kline
+		if n > 0 && kline.OpenTime > b.klines[n-1].OpenTime+step {
+			page, err := b.fetch(context.Background(), b.klines[n-1].OpenTime+step, kline.OpenTime-1)
+			if err != nil {
+				errHandler(fmt.Errorf("fetch klines missing before %d: %w", kline.OpenTime, err))
+			}
+			added = b.merge(page, kline.OpenTime)
+		}
+		added = append(added, b.merge([]*Kline{kline}, kline.CloseTime+1)...)
+		b.mu.Unlock()
+		for _, k := range added {
+			handler(k)
+		}
+	}, errHandler, WithWsClient(b.c))
+}
+
+func (b *KlineBackfill) step() (int64, error) {
+	d, ok := klineIntervals[b.interval]
+	if !ok {
+		return 0, fmt.Errorf("invalid interval %q", b.interval)
+	}
+	return d.Milliseconds(), nil
+}
+
+func (b *KlineBackfill) fetch(ctx context.Context, startTime, endTime int64) ([]*Kline, error) {
+	return b.c.NewKlinesService().Symbol(b.symbol).Interval(b.interval).
+		StartTime(startTime).EndTime(endTime).Limit(b.limit).Do(ctx)
+}
+
+// merge add the klines closed before now which are not in the series yet and return them,
+// it must be called with mu held
+func (b *KlineBackfill) merge(klines []*Kline, now int64) []*Kline {
+	var added []*Kline
+	for _, k := range klines {
+		if b.seen[k.OpenTime] || k.OpenTime < b.startTime || k.CloseTime >= now {
+			continue
+		}
+		b.seen[k.OpenTime] = true
+		added = append(added, k)
+	}
+	if len(added) > 0 {
+		b.klines = append(b.klines, added...)
+		sort.Slice(b.klines, func(i, j int) bool {
+			return b.klines[i].OpenTime < b.klines[j].OpenTime
+		})
+	}
+	return added
+}
+
+// fillGaps fetch again the ranges missing from the series, it must be called with mu held
+func (b *KlineBackfill) fillGaps(ctx context.Context, step, now int64) error {
+	for _, gap := range b.gaps(step) {
+		page, err := b.fetch(ctx, gap[0], gap[1])
+		if err != nil {
+			return err
+		}
+		b.merge(page, now)
+	}
+	if gaps := b.gaps(step); len(gaps) > 0 {
+		return fmt.Errorf("%w: missing klines between %d and %d", ErrKlineGap, gaps[0][0], gaps[0][1])
+	}
+	return nil
+}
+
+// gaps return the [startTime, endTime] ranges missing from the series
+func (b *KlineBackfill) gaps(step int64) [][2]int64 {
+	var gaps [][2]int64
+	for i := 1; i < len(b.klines); i++ {
+		if next := b.klines[i-1].OpenTime + step; b.klines[i].OpenTime > next {
+			gaps = append(gaps, [2]int64{next, b.klines[i].OpenTime - 1})
+		}
+	}
+	return gaps
+}
+
+func klineFromWs(k *WsKline) *Kline {
+	return &Kline{
+		OpenTime:                 k.StartTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.EndTime,
+		QuoteAssetVolume:         k.QuoteVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.ActiveBuyVolume,
+		TakerBuyQuoteAssetVolume: k.ActiveBuyQuoteVolume,
+	}
+}
@@ -0,0 +1,109 @@
+package futures
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type klineBackfillTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+}
+
+func TestKlineBackfill(t *testing.T) {
+	suite.Run(t, new(klineBackfillTestSuite))
+}
+
+func (s *klineBackfillTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServe
+}
+
+func (s *klineBackfillTestSuite) TearDownTest() {
+	wsServe = s.origWsServe
+}
+
+const klineBackfillStart = int64(1712730000000)
+
+// klinesData return a klines response with the 1m klines of the given indexes from klineBackfillStart
+func klinesData(indexes ...int) []byte {
+	rows := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		openTime := klineBackfillStart + int64(i)*60000
+		rows = append(rows, fmt.Sprintf(`[%d,"60000","60100","59900","60050","10",%d,"600500",100,"5","300250"]`, openTime, openTime+59999))
+	}
+	return []byte("[" + strings.Join(rows, ",") + "]")
+}
+
+func (s *klineBackfillTestSuite) assertContiguous(klines []*Kline, n int) {
+	s.r().Len(klines, n)
+	for i, k := range klines {
+		s.r().Equal(klineBackfillStart+int64(i)*60000, k.OpenTime, "kline %d", i)
+	}
+}
+
+func (s *klineBackfillTestSuite) TestPagedBackfillWithGap() {
+	s.mockDoOnce(klinesData(0, 1, 2), nil)
+	// the second page overlap the first one and miss kline 4
+	s.mockDoOnce(klinesData(2, 3, 5), nil)
+	s.mockDoOnce(klinesData(6), nil)
+	// the missing kline is fetched again
+	s.mockDoOnce(klinesData(4), nil)
+
+	b := s.client.NewKlineBackfill("BTCUSDT", "1m", klineBackfillStart).Limit(3)
+	klines, err := b.Do(newContext())
+	s.r().NoError(err)
+	s.assertContiguous(klines, 7)
+	s.assertContiguous(b.Klines(), 7)
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+}
+
+func (s *klineBackfillTestSuite) TestPersistentGap() {
+	s.mockDoOnce(klinesData(0, 1, 3), nil)
+	s.mockDoOnce(klinesData(), nil)
+
+	_, err := s.client.NewKlineBackfill("BTCUSDT", "1m", klineBackfillStart).Do(newContext())
+	s.r().ErrorIs(err, ErrKlineGap)
+	s.r().EqualError(err, fmt.Sprintf("kline series has a gap: missing klines between %d and %d",
+		klineBackfillStart+120000, klineBackfillStart+179999))
+}
+
+func (s *klineBackfillTestSuite) TestInvalidInterval() {
+	_, err := s.client.NewKlineBackfill("BTCUSDT", "1M", klineBackfillStart).Do(newContext())
+	s.r().EqualError(err, `invalid interval "1M"`)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *klineBackfillTestSuite) TestStream() {
+	s.mockDoOnce(klinesData(0, 1), nil)
+	b := s.client.NewKlineBackfill("BTCUSDT", "1m", klineBackfillStart)
+	_, err := b.Do(newContext())
+	s.r().NoError(err)
+
+	wsKline := func(i int, final bool) []byte {
+		openTime := klineBackfillStart + int64(i)*60000
+		return []byte(fmt.Sprintf(`{"e":"kline","E":%d,"s":"BTCUSDT","k":{"t":%d,"T":%d,"s":"BTCUSDT","i":"1m","o":"60000","c":"60050","h":"60100","l":"59900","v":"10","n":100,"x":%t,"q":"600500","V":"5","Q":"300250"}}`,
+			openTime+59999, openTime, openTime+59999, final))
+	}
+	// kline 3 arrive after a gap, kline 2 is fetched from the REST API
+	s.mockDoOnce(klinesData(2), nil)
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		handler(wsKline(1, true))
+		handler(wsKline(3, false))
+		handler(wsKline(3, true))
+		handler(wsKline(4, true))
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	var appended []int64
+	_, _, err = b.Stream(func(kline *Kline) {
+		appended = append(appended, kline.OpenTime)
+	}, func(err error) {
+		s.T().Error(err)
+	})
+	s.r().NoError(err)
+	s.r().Equal([]int64{klineBackfillStart + 120000, klineBackfillStart + 180000, klineBackfillStart + 240000}, appended)
+	s.assertContiguous(b.Klines(), 5)
+}
@@ -0,0 +1,40 @@
+package futures
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// LatestPrices return the latest price of each symbol, all the symbols when none is given.
+// The prices of all the symbols are fetched in a single request whatever the number of symbols
+// to save request weight.
+func (c *Client) LatestPrices(ctx context.Context, symbols ...string) (map[string]decimal.Decimal, error) {
+	prices, err := c.NewListPricesService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bySymbol := make(map[string]string, len(prices))
+	for _, p := range prices {
+		bySymbol[p.Symbol] = p.Price
+	}
+	if len(symbols) == 0 {
+		symbols = make([]string, 0, len(prices))
+		for _, p := range prices {
+			symbols = append(symbols, p.Symbol)
+		}
+	}
+	res := make(map[string]decimal.Decimal, len(symbols))
+	for _, symbol := range symbols {
+		price, ok := bySymbol[symbol]
+		if !ok {
+			return nil, fmt.Errorf("no price for symbol %s", symbol)
+		}
+		res[symbol], err = parseDecimal("price of "+symbol, price)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
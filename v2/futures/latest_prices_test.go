@@ -0,0 +1,53 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type latestPricesTestSuite struct {
+	baseTestSuite
+}
+
+func TestLatestPrices(t *testing.T) {
+	suite.Run(t, new(latestPricesTestSuite))
+}
+
+var latestPricesData = []byte(`[
+	{"symbol": "BTCUSDT", "price": "60000.10"},
+	{"symbol": "ETHUSDT", "price": "2500.5"},
+	{"symbol": "SOLUSDT", "price": "150"},
+	{"symbol": "BNBUSDT", "price": "580.25"}
+]`)
+
+func (s *latestPricesTestSuite) TestLatestPrices() {
+	s.mockDoOnce(latestPricesData, nil)
+
+	prices, err := s.client.LatestPrices(newContext(), "BTCUSDT", "SOLUSDT", "BNBUSDT")
+	r := s.r()
+	r.NoError(err)
+	r.Len(prices, 3)
+	r.Equal("60000.1", prices["BTCUSDT"].String())
+	r.Equal("150", prices["SOLUSDT"].String())
+	r.Equal("580.25", prices["BNBUSDT"].String())
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *latestPricesTestSuite) TestLatestPricesAll() {
+	s.mockDoOnce(latestPricesData, nil)
+
+	prices, err := s.client.LatestPrices(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(prices, 4)
+	r.Equal("2500.5", prices["ETHUSDT"].String())
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *latestPricesTestSuite) TestLatestPricesUnknownSymbol() {
+	s.mockDoOnce(latestPricesData, nil)
+
+	_, err := s.client.LatestPrices(newContext(), "BTCUSDT", "XRPUSDT")
+	s.r().EqualError(err, "no price for symbol XRPUSDT")
+}
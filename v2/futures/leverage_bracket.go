@@ -0,0 +1,87 @@
+package futures
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// LeverageBrackets cache leverage brackets of all symbols and refresh them after ttl
+type LeverageBrackets struct {
+	c         *Client
+	ttl       time.Duration
+	mu        sync.Mutex
+	brackets  map[string][]Bracket
+	updatedAt time.Time
+}
+
+// NewLeverageBrackets init a leverage bracket cache, brackets are loaded lazily on first lookup
+func (c *Client) NewLeverageBrackets(ttl time.Duration) *LeverageBrackets {
+	return &LeverageBrackets{c: c, ttl: ttl}
+}
+
+// Refresh reload leverage brackets of all symbols
+func (b *LeverageBrackets) Refresh(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.refresh(ctx)
+}
+
+func (b *LeverageBrackets) refresh(ctx context.Context) error {
+	res, err := b.c.NewGetLeverageBracketService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	brackets := make(map[string][]Bracket, len(res))
+	for _, lb := range res {
+		brackets[lb.Symbol] = lb.Brackets
+	}
+	b.brackets = brackets
+	b.updatedAt = time.Now()
+	return nil
+}
+
+// Brackets return cached brackets of symbol, refreshing the cache when it is expired
+func (b *LeverageBrackets) Brackets(ctx context.Context, symbol string) ([]Bracket, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.brackets == nil || time.Since(b.updatedAt) >= b.ttl {
+		if err := b.refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+	brackets, ok := b.brackets[symbol]
+	if !ok {
+		return nil, fmt.Errorf("no leverage brackets for symbol %s", symbol)
+	}
+	return brackets, nil
+}
+
+// MaintMarginRate return maintenance margin rate and maintenance amount of the bracket tier notional falls in
+func (b *LeverageBrackets) MaintMarginRate(ctx context.Context, symbol string, notional decimal.Decimal) (rate, maintAmount decimal.Decimal, err error) {
+	brackets, err := b.Brackets(ctx, symbol)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	bracket, err := findBracket(brackets, notional)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%s: %w", symbol, err)
+	}
+	return decimal.NewFromFloat(bracket.MaintMarginRatio), decimal.NewFromFloat(bracket.Cum), nil
+}
+
+// findBracket select the tier with notionalFloor <= |notional| < notionalCap
+func findBracket(brackets []Bracket, notional decimal.Decimal) (*Bracket, error) {
+	notional = notional.Abs()
+	for i := range brackets {
+		floor := decimal.NewFromFloat(brackets[i].NotionalFloor)
+		limit := decimal.NewFromFloat(brackets[i].NotionalCap)
+		if notional.GreaterThanOrEqual(floor) && notional.LessThan(limit) {
+			return &brackets[i], nil
+		}
+	}
+	return nil, fmt.Errorf("notional %s exceeds all leverage brackets", notional)
+}
@@ -0,0 +1,89 @@
+package futures
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type leverageBracketsTestSuite struct {
+	baseTestSuite
+}
+
+func TestLeverageBrackets(t *testing.T) {
+	suite.Run(t, new(leverageBracketsTestSuite))
+}
+
+var leverageBracketsData = []byte(`[
+	{
+		"symbol": "BTCUSDT",
+		"brackets": [
+			{"bracket": 1, "initialLeverage": 125, "notionalCap": 50000, "notionalFloor": 0, "maintMarginRatio": 0.004, "cum": 0},
+			{"bracket": 2, "initialLeverage": 100, "notionalCap": 250000, "notionalFloor": 50000, "maintMarginRatio": 0.005, "cum": 50},
+			{"bracket": 3, "initialLeverage": 50, "notionalCap": 3000000, "notionalFloor": 250000, "maintMarginRatio": 0.01, "cum": 1300}
+		]
+	}
+]`)
+
+func (s *leverageBracketsTestSuite) TestMaintMarginRate() {
+	s.mockDo(leverageBracketsData, nil)
+	defer s.assertDo()
+	brackets := s.client.NewLeverageBrackets(time.Hour)
+
+	tests := []struct {
+		notional    string
+		rate        string
+		maintAmount string
+	}{
+		{"0", "0.004", "0"},
+		{"49999.99", "0.004", "0"},
+		{"50000", "0.005", "50"},
+		{"-50000", "0.005", "50"},
+		{"249999.99", "0.005", "50"},
+		{"250000", "0.01", "1300"},
+		{"2999999", "0.01", "1300"},
+	}
+	for _, tt := range tests {
+		rate, maintAmount, err := brackets.MaintMarginRate(newContext(), "BTCUSDT", decimal.RequireFromString(tt.notional))
+		s.r().NoError(err, tt.notional)
+		s.r().Equal(tt.rate, rate.String(), tt.notional)
+		s.r().Equal(tt.maintAmount, maintAmount.String(), tt.notional)
+	}
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+
+	_, _, err := brackets.MaintMarginRate(newContext(), "BTCUSDT", decimal.NewFromInt(3000000))
+	s.r().EqualError(err, "BTCUSDT: notional 3000000 exceeds all leverage brackets")
+	_, _, err = brackets.MaintMarginRate(newContext(), "ETHUSDT", decimal.NewFromInt(1))
+	s.r().EqualError(err, "no leverage brackets for symbol ETHUSDT")
+}
+
+func (s *leverageBracketsTestSuite) TestRefreshAfterTTL() {
+	s.client.Client.do = s.client.do
+	s.client.HTTPClient.Transport = roundTripperFunc(s.client.do)
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(leverageBracketsData, http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(leverageBracketsData, http.StatusOK), nil).Once()
+	brackets := s.client.NewLeverageBrackets(time.Minute)
+
+	_, err := brackets.Brackets(newContext(), "BTCUSDT")
+	s.r().NoError(err)
+	brackets.updatedAt = time.Now().Add(-2 * time.Minute)
+	res, err := brackets.Brackets(newContext(), "BTCUSDT")
+	s.r().NoError(err)
+	s.r().Len(res, 3)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *leverageBracketsTestSuite) TestContext() {
+	s.mockDoOnce(leverageBracketsData, nil)
+	brackets := s.client.NewLeverageBrackets(time.Hour)
+	ctx, cancel := context.WithCancel(newContext())
+	cancel()
+
+	_, _ = brackets.Brackets(ctx, "BTCUSDT")
+	req := s.client.Calls[0].Arguments.Get(0).(*http.Request)
+	s.r().ErrorIs(req.Context().Err(), context.Canceled)
+}
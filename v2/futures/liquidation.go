@@ -0,0 +1,101 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// EstimateLiquidationPrice estimate liquidation price of a single position with the exchange formula
+//
+//	LP = (WB + cum - side * size * entryPrice) / (size * MMR - side * size)
+//
+// WB is pos.IsolatedWallet for isolated positions and walletBalance for cross positions.
+// In cross mode, walletBalance should already exclude maintenance margin and include
+// unrealized PnL of the other positions sharing the wallet. Zero is returned when the
+// position cannot be liquidated.
+func EstimateLiquidationPrice(ctx context.Context, pos *PositionRisk, walletBalance decimal.Decimal, brackets *LeverageBrackets) (decimal.Decimal, error) {
+	amount, err := parseDecimal("positionAmt", pos.PositionAmt)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if amount.IsZero() {
+		return decimal.Zero, errors.New("position is empty")
+	}
+	entryPrice, err := parseDecimal("entryPrice", pos.EntryPrice)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	var notional decimal.Decimal
+	if pos.Notional != "" {
+		if notional, err = parseDecimal("notional", pos.Notional); err != nil {
+			return decimal.Zero, err
+		}
+	} else {
+		markPrice, err := parseDecimal("markPrice", pos.MarkPrice)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		notional = amount.Mul(markPrice)
+	}
+	wallet := walletBalance
+	if strings.EqualFold(pos.MarginType, string(MarginTypeIsolated)) {
+		if wallet, err = parseDecimal("isolatedWallet", pos.IsolatedWallet); err != nil {
+			return decimal.Zero, err
+		}
+	}
+	mmr, cum, err := brackets.MaintMarginRate(ctx, pos.Symbol, notional)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	side := decimal.NewFromInt(int64(amount.Sign()))
+	size := amount.Abs()
+	numerator := wallet.Add(cum).Sub(side.Mul(size).Mul(entryPrice))
+	denominator := size.Mul(mmr).Sub(side.Mul(size))
+	if denominator.IsZero() {
+		return decimal.Zero, errors.New("invalid maintenance margin rate")
+	}
+	price := numerator.Div(denominator)
+	if price.IsNegative() {
+		return decimal.Zero, nil
+	}
+	return price, nil
+}
+
+// LiquidationDistance return how far markPrice is from liqPrice toward the liquidation of the position,
+// as a percentage of markPrice and in ticks of tickSize rounded down. The distance is negative when markPrice
+// is already beyond liqPrice, below it for a long and above it for a short.
+func LiquidationDistance(pos *PositionRisk, markPrice, liqPrice, tickSize decimal.Decimal) (pct decimal.Decimal, ticks int, err error) {
+	amount, err := parseDecimal("positionAmt", pos.PositionAmt)
+	if err != nil {
+		return decimal.Zero, 0, err
+	}
+	if amount.IsZero() {
+		return decimal.Zero, 0, errors.New("position is empty")
+	}
+	if !markPrice.IsPositive() {
+		return decimal.Zero, 0, fmt.Errorf("mark price must be positive, got %s", markPrice)
+	}
+	if !tickSize.IsPositive() {
+		return decimal.Zero, 0, fmt.Errorf("tick size must be positive, got %s", tickSize)
+	}
+	distance := markPrice.Sub(liqPrice)
+	if amount.IsNegative() {
+		distance = distance.Neg()
+	}
+	pct = distance.Div(markPrice).Mul(decimal.NewFromInt(100))
+	ticks = int(distance.Div(tickSize).Truncate(0).IntPart())
+	return pct, ticks, nil
+}
+
+func parseDecimal(name, value string) (decimal.Decimal, error) {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid %s %q: %w", name, value, err)
+	}
+	return d, nil
+}
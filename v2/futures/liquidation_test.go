@@ -0,0 +1,100 @@
+package futures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type liquidationTestSuite struct {
+	baseTestSuite
+	brackets *LeverageBrackets
+}
+
+func TestLiquidation(t *testing.T) {
+	suite.Run(t, new(liquidationTestSuite))
+}
+
+func (s *liquidationTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.brackets = &LeverageBrackets{
+		ttl:       time.Hour,
+		updatedAt: time.Now(),
+		brackets: map[string][]Bracket{
+			"BTCUSDT": {
+				{Bracket: 1, InitialLeverage: 125, NotionalCap: 50000, NotionalFloor: 0, MaintMarginRatio: 0.004, Cum: 0},
+				{Bracket: 2, InitialLeverage: 100, NotionalCap: 250000, NotionalFloor: 50000, MaintMarginRatio: 0.005, Cum: 50},
+			},
+		},
+	}
+}
+
+func (s *liquidationTestSuite) TestEstimateLiquidationPrice() {
+	tests := []struct {
+		name          string
+		marginType    string
+		positionAmt   string
+		notional      string
+		walletBalance string
+		expected      string
+	}{
+		{"isolated long", "isolated", "1", "50000", "0", "45175.88"},
+		{"isolated short", "isolated", "-1", "-50000", "0", "54776.12"},
+		{"cross long", "cross", "1", "50000", "10000", "40150.75"},
+		{"cross short", "cross", "-1", "-50000", "10000", "59751.24"},
+		{"cross long without liquidation", "cross", "1", "50000", "60000", "0"},
+	}
+	for _, tt := range tests {
+		pos := &PositionRisk{
+			Symbol:         "BTCUSDT",
+			MarginType:     tt.marginType,
+			PositionAmt:    tt.positionAmt,
+			EntryPrice:     "50000",
+			MarkPrice:      "50000",
+			Notional:       tt.notional,
+			IsolatedWallet: "5000",
+		}
+		price, err := EstimateLiquidationPrice(newContext(), pos, decimal.RequireFromString(tt.walletBalance), s.brackets)
+		s.r().NoError(err, tt.name)
+		s.r().Equal(tt.expected, price.Round(2).String(), tt.name)
+	}
+}
+
+func (s *liquidationTestSuite) TestEstimateLiquidationPriceEmptyPosition() {
+	pos := &PositionRisk{Symbol: "BTCUSDT", PositionAmt: "0", EntryPrice: "0"}
+	_, err := EstimateLiquidationPrice(newContext(), pos, decimal.Zero, s.brackets)
+	s.r().EqualError(err, "position is empty")
+}
+
+func (s *liquidationTestSuite) TestLiquidationDistance() {
+	tests := []struct {
+		name        string
+		positionAmt string
+		markPrice   string
+		liqPrice    string
+		tickSize    string
+		pct         string
+		ticks       int
+	}{
+		{"long", "0.5", "60000", "54000", "0.1", "10", 60000},
+		{"short", "-0.5", "60000", "63000", "0.1", "5", 30000},
+		{"long beyond liquidation", "2", "1600", "2000", "0.01", "-25", -40000},
+		{"short partial tick", "-10", "2", "2.00255", "0.001", "0.1275", 2},
+	}
+	for _, tt := range tests {
+		pct, ticks, err := LiquidationDistance(&PositionRisk{Symbol: "BTCUSDT", PositionAmt: tt.positionAmt},
+			decimal.RequireFromString(tt.markPrice), decimal.RequireFromString(tt.liqPrice), decimal.RequireFromString(tt.tickSize))
+		s.r().NoError(err, tt.name)
+		s.r().Equal(tt.pct, pct.String(), tt.name)
+		s.r().Equal(tt.ticks, ticks, tt.name)
+	}
+
+	_, _, err := LiquidationDistance(&PositionRisk{PositionAmt: "0"}, decimal.NewFromInt(100), decimal.NewFromInt(90), decimal.NewFromInt(1))
+	s.r().EqualError(err, "position is empty")
+	_, _, err = LiquidationDistance(&PositionRisk{PositionAmt: "1"}, decimal.NewFromInt(100), decimal.NewFromInt(90), decimal.Zero)
+	s.r().EqualError(err, "tick size must be positive, got 0")
+	_, _, err = LiquidationDistance(&PositionRisk{PositionAmt: "1"}, decimal.Zero, decimal.NewFromInt(90), decimal.NewFromInt(1))
+	s.r().EqualError(err, "mark price must be positive, got 0")
+}
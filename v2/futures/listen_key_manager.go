@@ -0,0 +1,254 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+// DefaultListenKeyKeepaliveInterval is the keepalive interval used when none is given,
+// a listenKey expires 60 minutes after its last keepalive
+var DefaultListenKeyKeepaliveInterval = 30 * time.Minute
+
+// ListenKeyManager create a listenKey and keep it alive in the background until closed
+type ListenKeyManager struct {
+	c          *Client
+	interval   time.Duration
+	errHandler ErrHandler
+
+	renewHandler    func(listenKey string)
+	safetyThreshold int
+	safetyCallback  func(err error)
+
+	mu        sync.Mutex
+	listenKey string
+	stopC     chan struct{}
+	doneC     chan struct{}
+	// cancelRenew and renewDoneC are set while an expired listenKey is being renewed
+	cancelRenew context.CancelFunc
+	renewDoneC  chan struct{}
+}
+
+// NewListenKeyManager init a listenKey manager, keepalive failures are reported to errHandler which may be nil
+func (c *Client) NewListenKeyManager(interval time.Duration, errHandler ErrHandler) *ListenKeyManager {
+	if interval <= 0 {
+		interval = DefaultListenKeyKeepaliveInterval
+	}
+	return &ListenKeyManager{c: c, interval: interval, errHandler: errHandler}
+}
+
+// Start create the listenKey and start the keepalive loop
+func (m *ListenKeyManager) Start(ctx context.Context) (listenKey string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopC != nil || m.renewDoneC != nil {
+		return "", errors.New("listenKey manager already started")
+	}
+	listenKey, err = m.c.NewStartUserStreamService().Do(ctx)
+	if err != nil {
+		return "", err
+	}
+	m.listenKey = listenKey
+	m.stopC = make(chan struct{})
+	m.doneC = make(chan struct{})
+	go m.keepalive(listenKey, m.stopC, m.doneC)
+	m.c.trackListenKeyManager(m, true)
+	return listenKey, nil
+}
+
+// trackListenKeyManager add a started manager to the ones closed by Client.Close, or remove a closed one
+func (c *Client) trackListenKeyManager(m *ListenKeyManager, started bool) {
+	c.listenKeyMu.Lock()
+	defer c.listenKeyMu.Unlock()
+	if !started {
+		delete(c.listenKeyManagers, m)
+		return
+	}
+	if c.listenKeyManagers == nil {
+		c.listenKeyManagers = make(map[*ListenKeyManager]struct{})
+	}
+	c.listenKeyManagers[m] = struct{}{}
+}
+
+// keepalive extend the validity of listenKey every interval until stopC is closed, which cancel a pending keepalive
+func (m *ListenKeyManager) keepalive(listenKey string, stopC, doneC chan struct{}) {
+	defer close(doneC)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stopC:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopC:
+			return
+		case <-ticker.C:
+			err := m.c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil && m.errHandler != nil {
+				m.errHandler(err)
+			}
+		}
+	}
+}
+
+// OnRenew set the handler called with the new listenKey after an expired one was renewed,
+// the user data stream must be reconnected with it
+func (m *ListenKeyManager) OnRenew(handler func(listenKey string)) *ListenKeyManager {
+	m.renewHandler = handler
+	return m
+}
+
+// SafetyCallback set the callback invoked when the listenKey expired and threshold renewal attempts in a row failed,
+// e.g. to cancel all open orders rather than trading without the user data stream
+func (m *ListenKeyManager) SafetyCallback(threshold int, callback func(err error)) *ListenKeyManager {
+	m.safetyThreshold = threshold
+	m.safetyCallback = callback
+	return m
+}
+
+// Handle consume a user data event and renew the listenKey on listenKeyExpired, other events are ignored.
+// The renewal run in the background until it succeeds, the safety threshold is reached or the manager is closed,
+// the listenKey is empty meanwhile.
+func (m *ListenKeyManager) Handle(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeListenKeyExpired {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopC == nil {
+		// not started, closed or already renewing
+		return
+	}
+	close(m.stopC)
+	keepaliveDoneC := m.doneC
+	m.stopC, m.doneC, m.listenKey = nil, nil, ""
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelRenew, m.renewDoneC = cancel, make(chan struct{})
+	go m.renew(ctx, keepaliveDoneC, m.renewDoneC)
+}
+
+// renew create a new listenKey, retrying with backoff until the safety threshold is reached or ctx is cancelled
+// by Close. mu is only held to update the state of the manager, not during the requests.
+func (m *ListenKeyManager) renew(ctx context.Context, keepaliveDoneC, doneC chan struct{}) {
+	defer close(doneC)
+	<-keepaliveDoneC
+
+	threshold := m.safetyThreshold
+	if threshold <= 0 {
+		threshold = 1
+	}
+	b := &backoff.Backoff{
+		Min:    ListenKeyRetryMinInterval,
+		Max:    ListenKeyRetryMaxInterval,
+		Factor: 2,
+	}
+	var err error
+	for attempt := 1; attempt <= threshold; attempt++ {
+		var listenKey string
+		listenKey, err = m.c.NewStartUserStreamService().Do(ctx)
+		if err == nil {
+			m.onRenewed(ctx, listenKey)
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		m.c.debug("listenKey renewal failed (attempt %d/%d): %v", attempt, threshold, err)
+		if attempt < threshold {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(b.Duration()):
+			}
+		}
+	}
+
+	m.mu.Lock()
+	if ctx.Err() != nil {
+		m.mu.Unlock()
+		return
+	}
+	m.cancelRenew()
+	m.cancelRenew, m.renewDoneC = nil, nil
+	m.mu.Unlock()
+	err = fmt.Errorf("renew expired listenKey after %d attempts: %w", threshold, err)
+	if m.errHandler != nil {
+		m.errHandler(err)
+	}
+	if m.safetyCallback != nil {
+		m.safetyCallback(err)
+	}
+}
+
+// onRenewed start the keepalive loop of the renewed listenKey, or close it when the manager was closed meanwhile
+func (m *ListenKeyManager) onRenewed(ctx context.Context, listenKey string) {
+	m.mu.Lock()
+	if ctx.Err() != nil {
+		m.mu.Unlock()
+		if err := m.c.NewCloseUserStreamService().ListenKey(listenKey).Do(context.Background()); err != nil && m.errHandler != nil {
+			m.errHandler(err)
+		}
+		return
+	}
+	m.cancelRenew()
+	m.cancelRenew, m.renewDoneC = nil, nil
+	m.listenKey = listenKey
+	m.stopC = make(chan struct{})
+	m.doneC = make(chan struct{})
+	go m.keepalive(listenKey, m.stopC, m.doneC)
+	m.mu.Unlock()
+	if m.renewHandler != nil {
+		m.renewHandler(listenKey)
+	}
+}
+
+// ListenKey return the current listenKey, empty before Start
+func (m *ListenKeyManager) ListenKey() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.listenKey
+}
+
+// Close stop the keepalive loop, or a pending renewal, and close the user stream
+func (m *ListenKeyManager) Close(ctx context.Context) error {
+	m.mu.Lock()
+	stopC, doneC, listenKey := m.stopC, m.doneC, m.listenKey
+	cancelRenew, renewDoneC := m.cancelRenew, m.renewDoneC
+	m.stopC, m.doneC, m.listenKey = nil, nil, ""
+	m.cancelRenew, m.renewDoneC = nil, nil
+	m.mu.Unlock()
+	if cancelRenew != nil {
+		m.c.trackListenKeyManager(m, false)
+		cancelRenew()
+		select {
+		case <-renewDoneC:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if stopC == nil {
+		return nil
+	}
+	m.c.trackListenKeyManager(m, false)
+	close(stopC)
+	select {
+	case <-doneC:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return m.c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+}
@@ -0,0 +1,215 @@
+package futures
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type listenKeyManagerTestSuite struct {
+	baseTestSuite
+}
+
+func TestListenKeyManager(t *testing.T) {
+	suite.Run(t, new(listenKeyManagerTestSuite))
+}
+
+func (s *listenKeyManagerTestSuite) TestKeepalive() {
+	s.mockDoOnce([]byte(`{"listenKey": "pqia91ma19a5s61cv6a81va65sdf19v8a65a1"}`), nil)
+	s.mockDo([]byte(`{}`), nil)
+
+	manager := s.client.NewListenKeyManager(5*time.Millisecond, nil)
+	listenKey, err := manager.Start(newContext())
+	s.r().NoError(err)
+	s.r().Equal("pqia91ma19a5s61cv6a81va65sdf19v8a65a1", listenKey)
+	s.r().Equal(listenKey, manager.ListenKey())
+
+	_, err = manager.Start(newContext())
+	s.r().EqualError(err, "listenKey manager already started")
+
+	time.Sleep(30 * time.Millisecond)
+	s.r().NoError(manager.Close(newContext()))
+	// start + at least two keepalives + close
+	s.r().GreaterOrEqual(len(s.client.Calls), 4)
+	s.r().NoError(manager.Close(newContext()))
+}
+
+func (s *listenKeyManagerTestSuite) TestKeepaliveError() {
+	ListenKeyMaxAttempts, ListenKeyRetryMinInterval = 1, time.Millisecond
+	defer func() {
+		ListenKeyMaxAttempts, ListenKeyRetryMinInterval = 3, 500*time.Millisecond
+	}()
+	s.mockDoOnce([]byte(`{"listenKey": "pqia91ma19a5s61cv6a81va65sdf19v8a65a1"}`), nil)
+	s.mockDoOnce(nil, errors.New("connection reset"))
+	s.mockDo([]byte(`{}`), nil)
+
+	var failures int32
+	manager := s.client.NewListenKeyManager(5*time.Millisecond, func(err error) {
+		atomic.AddInt32(&failures, 1)
+	})
+	_, err := manager.Start(newContext())
+	s.r().NoError(err)
+	time.Sleep(20 * time.Millisecond)
+	s.r().NoError(manager.Close(newContext()))
+	s.r().Equal(int32(1), atomic.LoadInt32(&failures))
+}
+
+func (s *listenKeyManagerTestSuite) TestCloseDuringBannedKeepalive() {
+	s.mockDoOnce([]byte(`{"listenKey": "pqia91ma19a5s61cv6a81va65sdf19v8a65a1"}`), nil)
+	s.mockDoOnce([]byte(`{"code":-1003,"msg":"Way too many requests; IP banned."}`), nil, http.StatusTeapot)
+	s.client.ExpectedCalls[1].ReturnArguments.Get(0).(*http.Response).Header = http.Header{"Retry-After": {"3600"}}
+	bannedC := make(chan struct{})
+	s.client.ExpectedCalls[1].Run(func(args mock.Arguments) { close(bannedC) })
+	s.mockDo([]byte(`{}`), nil)
+
+	manager := s.client.NewListenKeyManager(5*time.Millisecond, nil)
+	_, err := manager.Start(newContext())
+	s.r().NoError(err)
+	select {
+	case <-bannedC:
+	case <-time.After(time.Second):
+		s.T().Fatal("keepalive not sent")
+	}
+	// the keepalive waiting for the Retry-After is cancelled
+	start := time.Now()
+	s.r().NoError(manager.Close(newContext()))
+	s.r().Less(time.Since(start), time.Second)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *listenKeyManagerTestSuite) expire(manager *ListenKeyManager) {
+	event := new(WsUserDataEvent)
+	s.r().NoError(json.Unmarshal([]byte(`{"e": "listenKeyExpired", "E": 1576653824250}`), event))
+	manager.Handle(event)
+}
+
+// receive wait for the value sent on c by a renewal running in the background
+func (s *listenKeyManagerTestSuite) receive(c <-chan string) string {
+	select {
+	case v := <-c:
+		return v
+	case <-time.After(5 * time.Second):
+		s.T().Fatal("renewal not completed")
+	}
+	return ""
+}
+
+func (s *listenKeyManagerTestSuite) TestRenew() {
+	s.mockDoOnce([]byte(`{"listenKey": "expiredKey"}`), nil)
+	s.mockDoOnce([]byte(`{"listenKey": "renewedKey"}`), nil)
+	s.mockDo([]byte(`{}`), nil)
+
+	renewed := make(chan string, 1)
+	manager := s.client.NewListenKeyManager(time.Hour, nil).OnRenew(func(listenKey string) {
+		renewed <- listenKey
+	}).SafetyCallback(3, func(err error) {
+		s.T().Errorf("unexpected safety callback: %v", err)
+	})
+	_, err := manager.Start(newContext())
+	s.r().NoError(err)
+
+	s.expire(manager)
+	s.r().Equal("renewedKey", s.receive(renewed))
+	s.r().Equal("renewedKey", manager.ListenKey())
+	s.r().NoError(manager.Close(newContext()))
+}
+
+func (s *listenKeyManagerTestSuite) TestSafetyCallback() {
+	ListenKeyMaxAttempts, ListenKeyRetryMinInterval = 1, time.Millisecond
+	defer func() {
+		ListenKeyMaxAttempts, ListenKeyRetryMinInterval = 3, 500*time.Millisecond
+	}()
+	s.mockDoOnce([]byte(`{"listenKey": "expiredKey"}`), nil)
+	s.mockDo(nil, errors.New("connection reset"))
+
+	safetyErrs := make(chan string, 2)
+	manager := s.client.NewListenKeyManager(time.Hour, nil).SafetyCallback(2, func(err error) {
+		safetyErrs <- err.Error()
+	})
+	_, err := manager.Start(newContext())
+	s.r().NoError(err)
+
+	// events other than listenKeyExpired are ignored
+	manager.Handle(&WsUserDataEvent{Event: UserDataEventTypeAccountUpdate})
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+
+	s.expire(manager)
+	safetyErr := s.receive(safetyErrs)
+	s.r().Contains(safetyErr, "renew expired listenKey after 2 attempts")
+	s.r().Contains(safetyErr, "connection reset")
+	s.r().Empty(safetyErrs)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+	s.r().Empty(manager.ListenKey())
+	s.r().NoError(manager.Close(newContext()))
+}
+
+func (s *listenKeyManagerTestSuite) TestRenewInBackground() {
+	ListenKeyMaxAttempts, ListenKeyRetryMinInterval, ListenKeyRetryMaxInterval = 1, time.Hour, time.Hour
+	defer func() {
+		ListenKeyMaxAttempts, ListenKeyRetryMinInterval, ListenKeyRetryMaxInterval = 3, 500*time.Millisecond, 5*time.Second
+	}()
+	s.mockDoOnce([]byte(`{"listenKey": "expiredKey"}`), nil)
+	s.mockDo(nil, errors.New("connection reset"))
+	var requests atomic.Int32
+	s.assertReq(func(r *request) {
+		requests.Add(1)
+	})
+
+	manager := s.client.NewListenKeyManager(time.Hour, nil).SafetyCallback(3, func(err error) {
+		s.T().Errorf("unexpected safety callback: %v", err)
+	})
+	_, err := manager.Start(newContext())
+	s.r().NoError(err)
+
+	// the renewal wait an hour after its first attempt, it must neither block the stream nor the manager
+	start := time.Now()
+	s.expire(manager)
+	s.r().Eventually(func() bool {
+		return requests.Load() == 2
+	}, time.Second, time.Millisecond)
+	s.r().Empty(manager.ListenKey())
+	_, err = manager.Start(newContext())
+	s.r().EqualError(err, "listenKey manager already started")
+	s.r().NoError(manager.Close(newContext()))
+	s.r().Less(time.Since(start), time.Second)
+	s.r().Equal(int32(2), requests.Load())
+}
+
+func (s *listenKeyManagerTestSuite) TestClientClose() {
+	s.mockDoOnce([]byte(`{"listenKey": "key1"}`), nil)
+	s.mockDoOnce([]byte(`{"listenKey": "key2"}`), nil)
+	s.mockDo([]byte(`{}`), nil)
+
+	goroutines := runtime.NumGoroutine()
+	managers := []*ListenKeyManager{
+		s.client.NewListenKeyManager(time.Hour, nil),
+		s.client.NewListenKeyManager(time.Hour, nil),
+	}
+	for _, manager := range managers {
+		_, err := manager.Start(newContext())
+		s.r().NoError(err)
+	}
+	s.r().Greater(runtime.NumGoroutine(), goroutines)
+
+	s.r().NoError(s.client.Close())
+	for _, manager := range managers {
+		s.r().Empty(manager.ListenKey())
+	}
+	// two starts and two closes
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+	// Eventually run the condition in a goroutine of its own
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > goroutines && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.r().LessOrEqual(runtime.NumGoroutine(), goroutines)
+	s.r().NoError(s.client.Close())
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+}
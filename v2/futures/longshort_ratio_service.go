@@ -2,15 +2,16 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
+
+	"github.com/shopspring/decimal"
 )
 
 // LongShortRatioService list open history data of a symbol.
 type LongShortRatioService struct {
 	c         *Client
 	symbol    string
-	period    string
+	period    Period
 	limit     *int
 	startTime *int64
 	endTime   *int64
@@ -23,7 +24,7 @@ func (s *LongShortRatioService) Symbol(symbol string) *LongShortRatioService {
 }
 
 // Period set period interval
-func (s *LongShortRatioService) Period(period string) *LongShortRatioService {
+func (s *LongShortRatioService) Period(period Period) *LongShortRatioService {
 	s.period = period
 	return s
 }
@@ -48,6 +49,9 @@ func (s *LongShortRatioService) EndTime(endTime int64) *LongShortRatioService {
 
 // Do send request
 func (s *LongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	if err = validatePeriod(s.period); err != nil {
+		return nil, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/futures/data/globalLongShortAccountRatio",
@@ -72,7 +76,7 @@ func (s *LongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (
 	}
 
 	res = make([]*LongShortRatio, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*LongShortRatio{}, err
 	}
@@ -87,3 +91,18 @@ type LongShortRatio struct {
 	ShortAccount   string `json:"shortAccount"`
 	Timestamp      int64  `json:"timestamp"`
 }
+
+// LongShortRatioDecimal return longShortRatio as a decimal
+func (r *LongShortRatio) LongShortRatioDecimal() (decimal.Decimal, error) {
+	return parseDecimal("longShortRatio", r.LongShortRatio)
+}
+
+// LongAccountDecimal return longAccount as a decimal
+func (r *LongShortRatio) LongAccountDecimal() (decimal.Decimal, error) {
+	return parseDecimal("longAccount", r.LongAccount)
+}
+
+// ShortAccountDecimal return shortAccount as a decimal
+func (r *LongShortRatio) ShortAccountDecimal() (decimal.Decimal, error) {
+	return parseDecimal("shortAccount", r.ShortAccount)
+}
@@ -35,7 +35,7 @@ func (s *longShortRatioServiceTestSuite) TestOpenInterestStatistics() {
 	defer s.assertDo()
 
 	symbol := "BTCUSDT"
-	period := "15m"
+	period := Period15m
 	limit := 10
 	startTime := int64(1583139600000)
 	endTime := int64(1583139900000)
@@ -83,3 +83,23 @@ func (s *longShortRatioServiceTestSuite) assertLongShortRatioEqual(e, a *LongSho
 	r.Equal(e.LongAccount, a.LongAccount, "LongAccount")
 	r.Equal(e.ShortAccount, a.ShortAccount, "ShortAccount")
 }
+
+func (s *longShortRatioServiceTestSuite) TestLongShortRatioDecimal() {
+	s.mockDo([]byte(`[{"symbol": "BTCUSDT", "longShortRatio": "0.5576", "longAccount": "0.3580", "shortAccount": "0.6420", "timestamp": 1583139900000}]`), nil)
+	res, err := s.client.NewLongShortRatioService().Symbol("BTCUSDT").Period(Period1d).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	ratio, err := res[0].LongShortRatioDecimal()
+	r.NoError(err)
+	r.Equal("0.5576", ratio.String())
+	long, err := res[0].LongAccountDecimal()
+	r.NoError(err)
+	r.Equal("0.358", long.String())
+	short, err := res[0].ShortAccountDecimal()
+	r.NoError(err)
+	r.Equal("0.642", short.String())
+
+	_, err = s.client.NewLongShortRatioService().Symbol("BTCUSDT").Period("2d").Do(newContext())
+	r.EqualError(err, `invalid period "2d"`)
+}
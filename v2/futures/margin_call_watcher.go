@@ -0,0 +1,99 @@
+package futures
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MarginCallAlert is a MARGIN_CALL event of the user data stream with parsed values
+type MarginCallAlert struct {
+	Time time.Time
+	// CrossWalletBalance is zero when the event carries none, as for margin calls of isolated positions only
+	CrossWalletBalance decimal.Decimal
+	Positions          []*MarginCallPosition
+}
+
+// MarginCallPosition is a position at risk in a MarginCallAlert
+type MarginCallPosition struct {
+	Symbol                    string
+	Side                      PositionSideType
+	MarginType                MarginType
+	Amount                    decimal.Decimal
+	MarkPrice                 decimal.Decimal
+	UnrealizedPnL             decimal.Decimal
+	MaintenanceMarginRequired decimal.Decimal
+}
+
+// MarginCallWatcher surface the MARGIN_CALL events of the user data stream as alerts on a channel.
+// Feed it with Handle, e.g. WsUserDataServe(listenKey, watcher.Handle, errHandler).
+type MarginCallWatcher struct {
+	alerts     chan *MarginCallAlert
+	errHandler ErrHandler
+}
+
+// NewMarginCallWatcher init a watcher buffering up to size alerts, the oldest alert is dropped when
+// the buffer is full so that Handle never blocks the stream. Events that can't be parsed are passed to errHandler.
+func NewMarginCallWatcher(size int, errHandler ErrHandler) *MarginCallWatcher {
+	if size <= 0 {
+		size = 1
+	}
+	return &MarginCallWatcher{alerts: make(chan *MarginCallAlert, size), errHandler: errHandler}
+}
+
+// Alerts return the channel of the alerts
+func (w *MarginCallWatcher) Alerts() <-chan *MarginCallAlert {
+	return w.alerts
+}
+
+// Handle consume a user data event, events other than MARGIN_CALL are ignored
+func (w *MarginCallWatcher) Handle(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeMarginCall {
+		return
+	}
+	alert, err := newMarginCallAlert(event)
+	if err != nil {
+		if w.errHandler != nil {
+			w.errHandler(err)
+		}
+		return
+	}
+	for {
+		select {
+		case w.alerts <- alert:
+			return
+		default:
+		}
+		select {
+		case <-w.alerts:
+		default:
+		}
+	}
+}
+
+func newMarginCallAlert(event *WsUserDataEvent) (*MarginCallAlert, error) {
+	alert := &MarginCallAlert{Time: time.UnixMilli(event.Time)}
+	var err error
+	if event.CrossWalletBalance != "" {
+		if alert.CrossWalletBalance, err = parseDecimal("crossWalletBalance", event.CrossWalletBalance); err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range event.MarginCallPositions {
+		position := &MarginCallPosition{Symbol: p.Symbol, Side: p.Side, MarginType: p.MarginType}
+		if position.Amount, err = parseDecimal("positionAmt", p.Amount); err != nil {
+			return nil, err
+		}
+		if position.MarkPrice, err = parseDecimal("markPrice", p.MarkPrice); err != nil {
+			return nil, err
+		}
+		if position.UnrealizedPnL, err = parseDecimal("unrealizedPnL", p.UnrealizedPnL); err != nil {
+			return nil, err
+		}
+		if position.MaintenanceMarginRequired, err = parseDecimal("maintMargin", p.MaintenanceMarginRequired); err != nil {
+			return nil, err
+		}
+		alert.Positions = append(alert.Positions, position)
+	}
+	return alert, nil
+}
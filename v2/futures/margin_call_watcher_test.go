@@ -0,0 +1,102 @@
+package futures
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+var marginCallData = []byte(`{
+	"e": "MARGIN_CALL",
+	"E": 1587727187525,
+	"cw": "3.16812045",
+	"p": [
+		{
+			"s": "ETHUSDT",
+			"ps": "LONG",
+			"pa": "1.327",
+			"mt": "CROSSED",
+			"iw": "0",
+			"mp": "187.17127",
+			"up": "-1.166074",
+			"mm": "1.614445"
+		}
+	]
+}`)
+
+func TestMarginCallWatcher(t *testing.T) {
+	var errs []error
+	w := NewMarginCallWatcher(1, func(err error) { errs = append(errs, err) })
+
+	event := new(WsUserDataEvent)
+	require.NoError(t, json.Unmarshal(marginCallData, event))
+	w.Handle(event)
+	w.Handle(&WsUserDataEvent{Event: UserDataEventTypeListenKeyExpired})
+
+	alert := <-w.Alerts()
+	require.Equal(t, time.UnixMilli(1587727187525), alert.Time)
+	require.Equal(t, "3.16812045", alert.CrossWalletBalance.String())
+	require.Equal(t, []*MarginCallPosition{{
+		Symbol:                    "ETHUSDT",
+		Side:                      PositionSideTypeLong,
+		MarginType:                MarginTypeCrossed,
+		Amount:                    decimal.RequireFromString("1.327"),
+		MarkPrice:                 decimal.RequireFromString("187.17127"),
+		UnrealizedPnL:             decimal.RequireFromString("-1.166074"),
+		MaintenanceMarginRequired: decimal.RequireFromString("1.614445"),
+	}}, alert.Positions)
+	require.Empty(t, w.Alerts())
+	require.Empty(t, errs)
+
+	// the buffer is full, the oldest alert is dropped
+	w.Handle(event)
+	w.Handle(&WsUserDataEvent{Event: UserDataEventTypeMarginCall, Time: 1587727190000,
+		WsUserDataMarginCall: WsUserDataMarginCall{CrossWalletBalance: "2.5"}})
+	alert = <-w.Alerts()
+	require.Equal(t, "2.5", alert.CrossWalletBalance.String())
+	require.Empty(t, alert.Positions)
+
+	w.Handle(&WsUserDataEvent{Event: UserDataEventTypeMarginCall, WsUserDataMarginCall: WsUserDataMarginCall{CrossWalletBalance: "x"}})
+	require.Len(t, errs, 1)
+	require.Empty(t, w.Alerts())
+}
+
+func TestMarginCallWatcherIsolated(t *testing.T) {
+	var errs []error
+	w := NewMarginCallWatcher(1, func(err error) { errs = append(errs, err) })
+
+	event := new(WsUserDataEvent)
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"e": "MARGIN_CALL",
+		"E": 1587727187525,
+		"p": [
+			{
+				"s": "BTCUSDT",
+				"ps": "SHORT",
+				"pa": "-0.5",
+				"mt": "ISOLATED",
+				"iw": "120.5",
+				"mp": "65000",
+				"up": "-310.2",
+				"mm": "130"
+			}
+		]
+	}`), event))
+	w.Handle(event)
+
+	require.Empty(t, errs)
+	alert := <-w.Alerts()
+	require.True(t, alert.CrossWalletBalance.IsZero())
+	require.Equal(t, []*MarginCallPosition{{
+		Symbol:                    "BTCUSDT",
+		Side:                      PositionSideTypeShort,
+		MarginType:                MarginTypeIsolated,
+		Amount:                    decimal.RequireFromString("-0.5"),
+		MarkPrice:                 decimal.RequireFromString("65000"),
+		UnrealizedPnL:             decimal.RequireFromString("-310.2"),
+		MaintenanceMarginRequired: decimal.RequireFromString("130"),
+	}}, alert.Positions)
+}
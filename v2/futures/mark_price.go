@@ -2,10 +2,12 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/coin-quant/go-aster/v2/common"
+	"github.com/shopspring/decimal"
 )
 
 // PremiumIndexService get premium index
@@ -31,13 +33,13 @@ func (s *PremiumIndexService) Do(ctx context.Context, opts ...RequestOption) (re
 		"method": http.MethodGet,
 		"params": param,
 	}
-	data, err := s.c.call(m, false)
+	data, err := s.c.call(ctx, m, false, opts...)
 	data = common.ToJSONList(data)
 	if err != nil {
 		return []*PremiumIndex{}, err
 	}
 	res = make([]*PremiumIndex, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*PremiumIndex{}, err
 	}
@@ -56,6 +58,50 @@ type PremiumIndex struct {
 	Time                 int64  `json:"time"`
 }
 
+// MarkPriceDecimal return markPrice as a decimal
+func (p *PremiumIndex) MarkPriceDecimal() (decimal.Decimal, error) {
+	return parseDecimal("markPrice", p.MarkPrice)
+}
+
+// IndexPriceDecimal return indexPrice as a decimal
+func (p *PremiumIndex) IndexPriceDecimal() (decimal.Decimal, error) {
+	return parseDecimal("indexPrice", p.IndexPrice)
+}
+
+// EstimatedSettlePriceDecimal return estimatedSettlePrice as a decimal
+func (p *PremiumIndex) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return parseDecimal("estimatedSettlePrice", p.EstimatedSettlePrice)
+}
+
+// LastFundingRateDecimal return lastFundingRate as a decimal
+func (p *PremiumIndex) LastFundingRateDecimal() (decimal.Decimal, error) {
+	return parseDecimal("lastFundingRate", p.LastFundingRate)
+}
+
+// InterestRateDecimal return interestRate as a decimal
+func (p *PremiumIndex) InterestRateDecimal() (decimal.Decimal, error) {
+	return parseDecimal("interestRate", p.InterestRate)
+}
+
+// TimeToNextFunding return the time left until nextFundingTime, zero once it has passed
+func (p *PremiumIndex) TimeToNextFunding() time.Duration {
+	return max(time.Until(time.UnixMilli(p.NextFundingTime)), 0)
+}
+
+// TimeToNextFunding fetch the premium index of symbol and return the time left until its next funding
+func (c *Client) TimeToNextFunding(ctx context.Context, symbol string) (time.Duration, error) {
+	res, err := c.NewPremiumIndexService().Symbol(symbol).Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, p := range res {
+		if p.Symbol == symbol {
+			return p.TimeToNextFunding(), nil
+		}
+	}
+	return 0, fmt.Errorf("no premium index for symbol %s", symbol)
+}
+
 // FundingRateService get funding rate
 type FundingRateService struct {
 	c         *Client
@@ -77,7 +123,7 @@ func (s *FundingRateService) StartTime(startTime int64) *FundingRateService {
 	return s
 }
 
-// EndTime set startTime
+// EndTime set endTime
 func (s *FundingRateService) EndTime(endTime int64) *FundingRateService {
 	s.endTime = &endTime
 	return s
@@ -111,7 +157,7 @@ func (s *FundingRateService) Do(ctx context.Context, opts ...RequestOption) (res
 		return []*FundingRate{}, err
 	}
 	res = make([]*FundingRate, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*FundingRate{}, err
 	}
@@ -126,6 +172,11 @@ type FundingRate struct {
 	MarkPrice   string `json:"markPrice"`
 }
 
+// FundingRateDecimal return fundingRate as a decimal
+func (r *FundingRate) FundingRateDecimal() (decimal.Decimal, error) {
+	return parseDecimal("fundingRate", r.FundingRate)
+}
+
 // GetLeverageBracketService get funding rate
 type GetLeverageBracketService struct {
 	c      *Client
@@ -148,7 +199,7 @@ func (s *GetLeverageBracketService) Do(ctx context.Context, opts ...RequestOptio
 	if s.symbol != "" {
 		m["params"] = map[string]interface{}{"symbol": s.symbol}
 	}
-	data, err := s.c.call(m, true)
+	data, err := s.c.call(ctx, m, true, opts...)
 	if err != nil {
 		return []*LeverageBracket{}, err
 	}
@@ -158,7 +209,7 @@ func (s *GetLeverageBracketService) Do(ctx context.Context, opts ...RequestOptio
 	}
 
 	res = make([]*LeverageBracket, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*LeverageBracket{}, err
 	}
@@ -1,7 +1,9 @@
 package futures
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -52,6 +54,73 @@ func (s *premiumIndexServiceTestSuite) TestGetPremiumIndex() {
 	s.assertPremiumIndexEqual(e, res)
 }
 
+func (s *premiumIndexServiceTestSuite) TestGetPremiumIndexSingleSymbol() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"markPrice": "11012.80409769",
+		"indexPrice": "11781.80495970",
+		"estimatedSettlePrice": "11781.16138815",
+		"lastFundingRate": "-0.03750000",
+		"nextFundingTime": 1562569200000,
+		"interestRate": "0.00010000",
+		"time": 1562566020000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	res, err := s.client.NewPremiumIndexService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal("BTCUSDT", res[0].Symbol)
+	markPrice, err := res[0].MarkPriceDecimal()
+	s.r().NoError(err)
+	s.r().Equal("11012.80409769", markPrice.String())
+	rate, err := res[0].LastFundingRateDecimal()
+	s.r().NoError(err)
+	s.r().Equal("-0.0375", rate.String())
+	settle, err := res[0].EstimatedSettlePriceDecimal()
+	s.r().NoError(err)
+	s.r().Equal("11781.16138815", settle.String())
+}
+
+func (s *premiumIndexServiceTestSuite) TestGetPremiumIndexAllSymbols() {
+	data := []byte(`[
+		{"symbol": "BTCUSDT", "markPrice": "60000", "indexPrice": "60010", "lastFundingRate": "0.0001", "interestRate": "0.0001", "nextFundingTime": 1562569200000},
+		{"symbol": "ETHUSDT", "markPrice": "3000", "indexPrice": "3001", "lastFundingRate": "-0.0002", "interestRate": "0.0001", "nextFundingTime": 1562569200000}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	res, err := s.client.NewPremiumIndexService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 2)
+	s.r().Equal("ETHUSDT", res[1].Symbol)
+	indexPrice, err := res[1].IndexPriceDecimal()
+	s.r().NoError(err)
+	s.r().Equal("3001", indexPrice.String())
+	interestRate, err := res[1].InterestRateDecimal()
+	s.r().NoError(err)
+	s.r().Equal("0.0001", interestRate.String())
+}
+
+func (s *premiumIndexServiceTestSuite) TestTimeToNextFunding() {
+	next := time.Now().Add(2 * time.Hour).UnixMilli()
+	s.mockDoOnce([]byte(fmt.Sprintf(`{"symbol": "BTCUSDT", "nextFundingTime": %d}`, next)), nil)
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "nextFundingTime": 1562569200000}`), nil)
+	s.mockDoOnce([]byte(`[]`), nil)
+
+	countdown, err := s.client.TimeToNextFunding(newContext(), "BTCUSDT")
+	s.r().NoError(err)
+	s.r().InDelta(float64(2*time.Hour), float64(countdown), float64(time.Second))
+
+	countdown, err = s.client.TimeToNextFunding(newContext(), "BTCUSDT")
+	s.r().NoError(err)
+	s.r().Zero(countdown)
+
+	_, err = s.client.TimeToNextFunding(newContext(), "BTCUSDT")
+	s.r().EqualError(err, "no premium index for symbol BTCUSDT")
+}
+
 func (s *premiumIndexServiceTestSuite) assertPremiumIndexEqual(e, a []*PremiumIndex) {
 	r := s.r()
 	r.Equal(e[0].Symbol, a[0].Symbol, "Symbol")
@@ -0,0 +1,87 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// MarketSnapshotMaxParallel bound the number of symbols fetched concurrently by SnapshotMarket
+var MarketSnapshotMaxParallel = 5
+
+// MarketSnapshot hold the order book and the best bid and ask of a symbol
+type MarketSnapshot struct {
+	Symbol     string
+	Depth      *DepthResponse
+	BookTicker *BookTicker
+}
+
+// SnapshotMarket fetch the depth limited to depthLimit levels and the book ticker of each symbol,
+// at most MarketSnapshotMaxParallel symbols at a time. The snapshots fetched are returned even when
+// some symbols failed, the returned error joins the errors of those in symbols order.
+// The symbols not fetched yet are skipped once ctx is done.
+func (c *Client) SnapshotMarket(ctx context.Context, symbols []string, depthLimit int) (map[string]*MarketSnapshot, error) {
+	snapshots := make(map[string]*MarketSnapshot, len(symbols))
+	failures := make(map[string]error)
+	var mu sync.Mutex
+
+	workers := min(max(MarketSnapshotMaxParallel, 1), len(symbols))
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for symbol := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				snapshot, err := c.snapshotSymbol(ctx, symbol, depthLimit)
+				mu.Lock()
+				if err != nil {
+					failures[symbol] = err
+				} else {
+					snapshots[symbol] = snapshot
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+feed:
+	for _, symbol := range symbols {
+		select {
+		case jobs <- symbol:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	var errs []error
+	for _, symbol := range symbols {
+		if err, ok := failures[symbol]; ok {
+			errs = append(errs, fmt.Errorf("snapshot %s: %w", symbol, err))
+		}
+	}
+	if err := ctx.Err(); err != nil && len(snapshots)+len(failures) < len(symbols) {
+		errs = append(errs, err)
+	}
+	return snapshots, errors.Join(errs...)
+}
+
+func (c *Client) snapshotSymbol(ctx context.Context, symbol string, depthLimit int) (*MarketSnapshot, error) {
+	depth, err := c.NewDepthService().Symbol(symbol).Limit(depthLimit).Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("depth: %w", err)
+	}
+	tickers, err := c.NewListBookTickersService().Symbol(symbol).Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("book ticker: %w", err)
+	}
+	if len(tickers) == 0 {
+		return nil, errors.New("book ticker: empty response")
+	}
+	return &MarketSnapshot{Symbol: symbol, Depth: depth, BookTicker: tickers[0]}, nil
+}
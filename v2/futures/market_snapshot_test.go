@@ -0,0 +1,77 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type marketSnapshotTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarketSnapshot(t *testing.T) {
+	suite.Run(t, new(marketSnapshotTestSuite))
+}
+
+func (s *marketSnapshotTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	// queued responses are consumed in order, fetch one symbol at a time
+	parallel := MarketSnapshotMaxParallel
+	MarketSnapshotMaxParallel = 1
+	s.T().Cleanup(func() {
+		MarketSnapshotMaxParallel = parallel
+	})
+}
+
+func (s *marketSnapshotTestSuite) mockSymbol(symbol string, lastUpdateID int64) {
+	s.mockDoOnce([]byte(fmt.Sprintf(`{"lastUpdateId": %d, "E": 1569514978020, "T": 1569514978017,
+		"bids": [["100.0", "1.5"]], "asks": [["100.1", "2"]]}`, lastUpdateID)), nil)
+	s.mockDoOnce([]byte(fmt.Sprintf(`{"symbol": "%s", "bidPrice": "100.0", "bidQty": "1.5",
+		"askPrice": "100.1", "askQty": "2", "time": 1569514978020}`, symbol)), nil)
+}
+
+func (s *marketSnapshotTestSuite) TestSnapshotMarket() {
+	s.mockSymbol("BTCUSDT", 1)
+	s.mockSymbol("ETHUSDT", 2)
+	s.mockSymbol("SOLUSDT", 3)
+
+	snapshots, err := s.client.SnapshotMarket(newContext(), []string{"BTCUSDT", "ETHUSDT", "SOLUSDT"}, 5)
+	r := s.r()
+	r.NoError(err)
+	r.Len(snapshots, 3)
+	for i, symbol := range []string{"BTCUSDT", "ETHUSDT", "SOLUSDT"} {
+		snapshot := snapshots[symbol]
+		r.Equal(symbol, snapshot.Symbol)
+		r.Equal(int64(i+1), snapshot.Depth.LastUpdateID)
+		r.Equal("100.1", snapshot.Depth.Asks[0].Price)
+		r.Equal(symbol, snapshot.BookTicker.Symbol)
+	}
+	s.client.AssertNumberOfCalls(s.T(), "do", 6)
+}
+
+func (s *marketSnapshotTestSuite) TestSnapshotMarketPartial() {
+	s.mockSymbol("BTCUSDT", 1)
+	s.mockDoOnce(nil, errors.New("dummy error"))
+	s.mockSymbol("SOLUSDT", 3)
+
+	snapshots, err := s.client.SnapshotMarket(newContext(), []string{"BTCUSDT", "ETHUSDT", "SOLUSDT"}, 5)
+	r := s.r()
+	r.EqualError(err, "snapshot ETHUSDT: depth: dummy error")
+	r.Len(snapshots, 2)
+	r.Equal("BTCUSDT", snapshots["BTCUSDT"].BookTicker.Symbol)
+	r.Equal("SOLUSDT", snapshots["SOLUSDT"].BookTicker.Symbol)
+	r.NotContains(snapshots, "ETHUSDT")
+}
+
+func (s *marketSnapshotTestSuite) TestSnapshotMarketCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	snapshots, err := s.client.SnapshotMarket(ctx, []string{"BTCUSDT", "ETHUSDT"}, 5)
+	s.r().ErrorIs(err, context.Canceled)
+	s.r().Empty(snapshots)
+}
@@ -2,7 +2,7 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"net/http"
 )
 
@@ -31,7 +31,7 @@ func (s *GetOpenInterestService) Do(ctx context.Context, opts ...RequestOption)
 	}
 
 	res = new(OpenInterest)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +49,7 @@ type OpenInterest struct {
 type OpenInterestStatisticsService struct {
 	c         *Client
 	symbol    string
-	period    string
+	period    Period
 	limit     *int
 	startTime *int64
 	endTime   *int64
@@ -62,7 +62,7 @@ func (s *OpenInterestStatisticsService) Symbol(symbol string) *OpenInterestStati
 }
 
 // Period set period interval
-func (s *OpenInterestStatisticsService) Period(period string) *OpenInterestStatisticsService {
+func (s *OpenInterestStatisticsService) Period(period Period) *OpenInterestStatisticsService {
 	s.period = period
 	return s
 }
@@ -87,6 +87,9 @@ func (s *OpenInterestStatisticsService) EndTime(endTime int64) *OpenInterestStat
 
 // Do send request
 func (s *OpenInterestStatisticsService) Do(ctx context.Context, opts ...RequestOption) (res []*OpenInterestStatistic, err error) {
+	if err = validatePeriod(s.period); err != nil {
+		return nil, err
+	}
 	r := &request{
 		method:   http.MethodGet,
 		endpoint: "/futures/data/openInterestHist",
@@ -111,7 +114,7 @@ func (s *OpenInterestStatisticsService) Do(ctx context.Context, opts ...RequestO
 	}
 
 	res = make([]*OpenInterestStatistic, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*OpenInterestStatistic{}, err
 	}
@@ -119,6 +122,15 @@ func (s *OpenInterestStatisticsService) Do(ctx context.Context, opts ...RequestO
 	return res, nil
 }
 
+// validatePeriod check period is one of the defined Period values
+func validatePeriod(period Period) error {
+	switch period {
+	case Period5m, Period15m, Period30m, Period1h, Period2h, Period4h, Period6h, Period12h, Period1d:
+		return nil
+	}
+	return fmt.Errorf("invalid period %q", period)
+}
+
 type OpenInterestStatistic struct {
 	Symbol               string `json:"symbol"`
 	SumOpenInterest      string `json:"sumOpenInterest"`
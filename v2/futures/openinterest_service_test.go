@@ -64,7 +64,7 @@ func (s *openInterestServiceTestSuite) TestOpenInterestStatistics() {
 	defer s.assertDo()
 
 	symbol := "BTCUSDT"
-	period := "15m"
+	period := Period15m
 	limit := 10
 	startTime := int64(1499040000000)
 	endTime := int64(1499040000001)
@@ -0,0 +1,80 @@
+package futures
+
+import (
+	"context"
+	"time"
+)
+
+// OpenInterestStatsIterator page forward through the open interest statistics of a symbol, oldest first
+type OpenInterestStatsIterator struct {
+	c         *Client
+	symbol    string
+	period    Period
+	startTime int64
+	endTime   int64
+	limit     int
+
+	started bool
+	cursor  int64
+	pager[*OpenInterestStatistic]
+}
+
+// NewOpenInterestStatsIterator init an open interest statistics iterator for symbol and period
+func (c *Client) NewOpenInterestStatsIterator(symbol string, period Period) *OpenInterestStatsIterator {
+	it := &OpenInterestStatsIterator{c: c, symbol: symbol, period: period, limit: 500}
+	it.nextPage = it.fetch
+	return it
+}
+
+// StartTime set startTime in ms
+func (it *OpenInterestStatsIterator) StartTime(startTime int64) *OpenInterestStatsIterator {
+	it.startTime = startTime
+	return it
+}
+
+// EndTime set endTime in ms, default to now
+func (it *OpenInterestStatsIterator) EndTime(endTime int64) *OpenInterestStatsIterator {
+	it.endTime = endTime
+	return it
+}
+
+// Limit set page size, the API returns at most 500 rows
+func (it *OpenInterestStatsIterator) Limit(limit int) *OpenInterestStatsIterator {
+	it.limit = limit
+	return it
+}
+
+// Statistic return the current open interest statistic
+func (it *OpenInterestStatsIterator) Statistic() *OpenInterestStatistic {
+	return it.current
+}
+
+func (it *OpenInterestStatsIterator) fetch(ctx context.Context) (page []*OpenInterestStatistic, last bool, err error) {
+	if !it.started {
+		if err := validatePeriod(it.period); err != nil {
+			return nil, false, err
+		}
+		it.started = true
+		if it.endTime == 0 {
+			it.endTime = time.Now().UnixMilli()
+		}
+		it.cursor = it.startTime
+	}
+	stats, err := it.c.NewOpenInterestStatisticsService().Symbol(it.symbol).Period(it.period).
+		StartTime(it.cursor).EndTime(it.endTime).Limit(it.limit).Do(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, stat := range stats {
+		// skip rows already returned by the previous page
+		if stat.Timestamp < it.cursor {
+			continue
+		}
+		if stat.Timestamp > it.endTime {
+			break
+		}
+		page = append(page, stat)
+		it.cursor = stat.Timestamp + 1
+	}
+	return page, len(stats) < it.limit || len(page) == 0 || it.cursor > it.endTime, nil
+}
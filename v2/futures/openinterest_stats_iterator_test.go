@@ -0,0 +1,40 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type openInterestStatsIteratorTestSuite struct {
+	baseTestSuite
+}
+
+func TestOpenInterestStatsIterator(t *testing.T) {
+	suite.Run(t, new(openInterestStatsIteratorTestSuite))
+}
+
+const openInterestStatRow = `{"symbol": "BTCUSDT", "sumOpenInterest": "20403.637", "sumOpenInterestValue": "150570784.078", "timestamp": %d}`
+
+func (s *openInterestStatsIteratorTestSuite) TestPages() {
+	s.mockDoOnce(pageData(openInterestStatRow, 300000, 600000), nil)
+	s.mockDoOnce(pageData(openInterestStatRow, 600000, 900000), nil)
+	s.mockDoOnce(pageData(openInterestStatRow, 1200000), nil)
+
+	it := s.client.NewOpenInterestStatsIterator("BTCUSDT", Period5m).StartTime(300000).EndTime(3000000).Limit(2)
+	times := collect(&it.pager, func(stat *OpenInterestStatistic) int64 { return stat.Timestamp })
+	s.r().NoError(it.Err())
+	s.r().Equal([]int64{300000, 600000, 900000, 1200000}, times)
+	s.r().Nil(it.Statistic())
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *openInterestStatsIteratorTestSuite) TestInvalidPeriod() {
+	it := s.client.NewOpenInterestStatsIterator("BTCUSDT", "3m")
+	s.r().False(it.Next(newContext()))
+	s.r().EqualError(it.Err(), `invalid period "3m"`)
+
+	_, err := s.client.NewOpenInterestStatisticsService().Symbol("BTCUSDT").Do(newContext())
+	s.r().EqualError(err, `invalid period ""`)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
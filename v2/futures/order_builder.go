@@ -0,0 +1,329 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Errors reported by OrderBuilder.Validate, check them with errors.Is
+var (
+	ErrOrderSymbolRequired          = errors.New("symbol is required")
+	ErrOrderSideRequired            = errors.New("side is required")
+	ErrOrderTypeRequired            = errors.New("type is required")
+	ErrOrderQuantityRequired        = errors.New("quantity is required unless closePosition is set")
+	ErrOrderPriceRequired           = errors.New("price or priceMatch is required for LIMIT, STOP and TAKE_PROFIT orders")
+	ErrOrderStopPriceRequired       = errors.New("stopPrice is required for STOP, STOP_MARKET, TAKE_PROFIT and TAKE_PROFIT_MARKET orders")
+	ErrOrderCallbackRateRequired    = errors.New("callbackRate is required for TRAILING_STOP_MARKET orders")
+	ErrOrderReduceOnlyClosePosition = errors.New("reduceOnly can't be sent with closePosition")
+	ErrOrderClosePositionType       = errors.New("closePosition is only supported by STOP_MARKET and TAKE_PROFIT_MARKET orders")
+	ErrOrderPriceWithPriceMatch     = errors.New("price can't be sent with priceMatch")
+	ErrOrderPriceMatchType          = errors.New("priceMatch is only supported by LIMIT, STOP and TAKE_PROFIT orders")
+	ErrOrderPriceProtectType        = errors.New("priceProtect is only supported by STOP, STOP_MARKET, TAKE_PROFIT and TAKE_PROFIT_MARKET orders")
+	ErrOrderWorkingTypeType         = errors.New("workingType is only supported by STOP, STOP_MARKET, TAKE_PROFIT, TAKE_PROFIT_MARKET and TRAILING_STOP_MARKET orders")
+)
+
+// OrderBuilder accumulate order parameters and validate cross-field rules before creating the order
+type OrderBuilder struct {
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	positionSide            *PositionSideType
+	orderType               OrderType
+	timeInForce             *TimeInForceType
+	quantity                string
+	price                   string
+	stopPrice               string
+	reduceOnly              bool
+	closePosition           bool
+	priceMatch              *PriceMatchType
+	newClientOrderID        string
+	workingType             *WorkingType
+	activationPrice         string
+	callbackRate            string
+	priceProtect            bool
+	selfTradePreventionMode *SelfTradePreventionMode
+	goodTillDate            int64
+	newOrderRespType        NewOrderRespType
+}
+
+// NewOrderBuilder init an order builder
+func (c *Client) NewOrderBuilder() *OrderBuilder {
+	return &OrderBuilder{c: c}
+}
+
+// Symbol set symbol
+func (b *OrderBuilder) Symbol(symbol string) *OrderBuilder {
+	b.symbol = symbol
+	return b
+}
+
+// Side set side
+func (b *OrderBuilder) Side(side SideType) *OrderBuilder {
+	b.side = side
+	return b
+}
+
+// PositionSide set positionSide
+func (b *OrderBuilder) PositionSide(positionSide PositionSideType) *OrderBuilder {
+	b.positionSide = &positionSide
+	return b
+}
+
+// Type set type
+func (b *OrderBuilder) Type(orderType OrderType) *OrderBuilder {
+	b.orderType = orderType
+	return b
+}
+
+// TimeInForce set timeInForce
+func (b *OrderBuilder) TimeInForce(timeInForce TimeInForceType) *OrderBuilder {
+	b.timeInForce = &timeInForce
+	return b
+}
+
+// Quantity set quantity
+func (b *OrderBuilder) Quantity(quantity string) *OrderBuilder {
+	b.quantity = quantity
+	return b
+}
+
+// Price set price
+func (b *OrderBuilder) Price(price string) *OrderBuilder {
+	b.price = price
+	return b
+}
+
+// StopPrice set stopPrice
+func (b *OrderBuilder) StopPrice(stopPrice string) *OrderBuilder {
+	b.stopPrice = stopPrice
+	return b
+}
+
+// ReduceOnly set reduceOnly
+func (b *OrderBuilder) ReduceOnly(reduceOnly bool) *OrderBuilder {
+	b.reduceOnly = reduceOnly
+	return b
+}
+
+// ClosePosition set closePosition
+func (b *OrderBuilder) ClosePosition(closePosition bool) *OrderBuilder {
+	b.closePosition = closePosition
+	return b
+}
+
+// PriceMatch set priceMatch
+func (b *OrderBuilder) PriceMatch(priceMatch PriceMatchType) *OrderBuilder {
+	b.priceMatch = &priceMatch
+	return b
+}
+
+// NewClientOrderID set newClientOrderID
+func (b *OrderBuilder) NewClientOrderID(newClientOrderID string) *OrderBuilder {
+	b.newClientOrderID = newClientOrderID
+	return b
+}
+
+// WorkingType set workingType
+func (b *OrderBuilder) WorkingType(workingType WorkingType) *OrderBuilder {
+	b.workingType = &workingType
+	return b
+}
+
+// ActivationPrice set activationPrice
+func (b *OrderBuilder) ActivationPrice(activationPrice string) *OrderBuilder {
+	b.activationPrice = activationPrice
+	return b
+}
+
+// CallbackRate set callbackRate
+func (b *OrderBuilder) CallbackRate(callbackRate string) *OrderBuilder {
+	b.callbackRate = callbackRate
+	return b
+}
+
+// PriceProtect set priceProtect
+func (b *OrderBuilder) PriceProtect(priceProtect bool) *OrderBuilder {
+	b.priceProtect = priceProtect
+	return b
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (b *OrderBuilder) SelfTradePreventionMode(mode SelfTradePreventionMode) *OrderBuilder {
+	b.selfTradePreventionMode = &mode
+	return b
+}
+
+// GoodTillDate set goodTillDate in milliseconds
+func (b *OrderBuilder) GoodTillDate(goodTillDate int64) *OrderBuilder {
+	b.goodTillDate = goodTillDate
+	return b
+}
+
+// NewOrderResponseType set newOrderRespType
+func (b *OrderBuilder) NewOrderResponseType(newOrderRespType NewOrderRespType) *OrderBuilder {
+	b.newOrderRespType = newOrderRespType
+	return b
+}
+
+func priceMatchSupported(orderType OrderType) bool {
+	switch orderType {
+	case OrderTypeLimit, OrderTypeStop, OrderTypeTakeProfit:
+		return true
+	}
+	return false
+}
+
+func priceProtectSupported(orderType OrderType) bool {
+	switch orderType {
+	case OrderTypeStop, OrderTypeStopMarket, OrderTypeTakeProfit, OrderTypeTakeProfitMarket:
+		return true
+	}
+	return false
+}
+
+func workingTypeSupported(orderType OrderType) bool {
+	return orderType == OrderTypeTrailingStopMarket || priceProtectSupported(orderType)
+}
+
+// validateWorkingType check workingType is one of the defined WorkingType values and allowed for orderType
+func validateWorkingType(workingType WorkingType, orderType OrderType) error {
+	switch workingType {
+	case WorkingTypeMarkPrice, WorkingTypeContractPrice:
+	default:
+		return fmt.Errorf("invalid workingType %q", workingType)
+	}
+	if !workingTypeSupported(orderType) {
+		return ErrOrderWorkingTypeType
+	}
+	return nil
+}
+
+func (b *OrderBuilder) hasPriceMatch() bool {
+	return b.priceMatch != nil && *b.priceMatch != PriceMatchTypeNone
+}
+
+// Validate check all the cross-field rules and return every violation joined in a single error
+func (b *OrderBuilder) Validate() error {
+	var errs []error
+	if b.symbol == "" {
+		errs = append(errs, ErrOrderSymbolRequired)
+	}
+	if b.side == "" {
+		errs = append(errs, ErrOrderSideRequired)
+	}
+	if b.orderType == "" {
+		errs = append(errs, ErrOrderTypeRequired)
+	}
+	if b.quantity == "" && !b.closePosition {
+		errs = append(errs, ErrOrderQuantityRequired)
+	}
+	if err := b.c.validatePositionSide(b.c.positionSideOrDefault(b.positionSide)); err != nil {
+		errs = append(errs, err)
+	}
+	switch b.orderType {
+	case OrderTypeLimit, OrderTypeStop, OrderTypeTakeProfit:
+		if b.price == "" && !b.hasPriceMatch() {
+			errs = append(errs, ErrOrderPriceRequired)
+		}
+	}
+	switch b.orderType {
+	case OrderTypeStop, OrderTypeStopMarket, OrderTypeTakeProfit, OrderTypeTakeProfitMarket:
+		if b.stopPrice == "" {
+			errs = append(errs, ErrOrderStopPriceRequired)
+		}
+	case OrderTypeTrailingStopMarket:
+		if b.callbackRate == "" {
+			errs = append(errs, ErrOrderCallbackRateRequired)
+		}
+	}
+	if b.reduceOnly && b.closePosition {
+		errs = append(errs, ErrOrderReduceOnlyClosePosition)
+	}
+	if b.closePosition && b.orderType != OrderTypeStopMarket && b.orderType != OrderTypeTakeProfitMarket {
+		errs = append(errs, ErrOrderClosePositionType)
+	}
+	if b.hasPriceMatch() {
+		if b.price != "" {
+			errs = append(errs, ErrOrderPriceWithPriceMatch)
+		}
+		if !priceMatchSupported(b.orderType) {
+			errs = append(errs, ErrOrderPriceMatchType)
+		}
+	}
+	if b.priceProtect && !priceProtectSupported(b.orderType) {
+		errs = append(errs, ErrOrderPriceProtectType)
+	}
+	if b.workingType != nil {
+		if err := validateWorkingType(*b.workingType, b.orderType); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Build validate the parameters and return a CreateOrderService ready to be sent
+func (b *OrderBuilder) Build() (*CreateOrderService, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	s := b.c.NewCreateOrderService().Symbol(b.symbol).Side(b.side).Type(b.orderType)
+	if b.quantity != "" {
+		s.Quantity(b.quantity)
+	}
+	if b.positionSide != nil {
+		s.PositionSide(*b.positionSide)
+	}
+	if b.timeInForce != nil {
+		s.TimeInForce(*b.timeInForce)
+	}
+	if b.price != "" {
+		s.Price(b.price)
+	}
+	if b.stopPrice != "" {
+		s.StopPrice(b.stopPrice)
+	}
+	if b.reduceOnly {
+		s.ReduceOnly(true)
+	}
+	if b.closePosition {
+		s.ClosePosition(true)
+	}
+	if b.priceMatch != nil {
+		s.PriceMatch(*b.priceMatch)
+	}
+	if b.newClientOrderID != "" {
+		s.NewClientOrderID(b.newClientOrderID)
+	}
+	if b.workingType != nil {
+		s.WorkingType(*b.workingType)
+	}
+	if b.activationPrice != "" {
+		s.ActivationPrice(b.activationPrice)
+	}
+	if b.callbackRate != "" {
+		s.CallbackRate(b.callbackRate)
+	}
+	if b.priceProtect {
+		s.PriceProtect(true)
+	}
+	if b.selfTradePreventionMode != nil {
+		s.SelfTradePreventionMode(*b.selfTradePreventionMode)
+	}
+	if b.goodTillDate > 0 {
+		s.GoodTillDate(b.goodTillDate)
+	}
+	if b.newOrderRespType != "" {
+		s.NewOrderResponseType(b.newOrderRespType)
+	}
+	return s, nil
+}
+
+// Do build the order and send it
+func (b *OrderBuilder) Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error) {
+	s, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return s.Do(ctx, opts...)
+}
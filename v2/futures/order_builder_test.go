@@ -0,0 +1,166 @@
+package futures
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderBuilderTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderBuilder(t *testing.T) {
+	suite.Run(t, new(orderBuilderTestSuite))
+}
+
+func (s *orderBuilderTestSuite) TestValidate() {
+	limit := func() *OrderBuilder {
+		return s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.01").Price("60000")
+	}
+	tests := []struct {
+		name    string
+		builder *OrderBuilder
+		errs    []error
+	}{
+		{
+			name:    "valid limit order",
+			builder: limit(),
+		},
+		{
+			name:    "valid market order",
+			builder: s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeMarket).Quantity("0.01"),
+		},
+		{
+			name:    "valid priceMatch limit order",
+			builder: limit().Price("").PriceMatch(PriceMatchTypeQueue5),
+		},
+		{
+			name: "valid close position stop market order",
+			builder: s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeSell).
+				Type(OrderTypeStopMarket).StopPrice("55000").ClosePosition(true),
+		},
+		{
+			name:    "missing symbol, side and type",
+			builder: s.client.NewOrderBuilder().Quantity("1"),
+			errs:    []error{ErrOrderSymbolRequired, ErrOrderSideRequired, ErrOrderTypeRequired},
+		},
+		{
+			name:    "missing quantity",
+			builder: limit().Quantity(""),
+			errs:    []error{ErrOrderQuantityRequired},
+		},
+		{
+			name:    "limit without price",
+			builder: limit().Price(""),
+			errs:    []error{ErrOrderPriceRequired},
+		},
+		{
+			name:    "limit with priceMatch NONE and no price",
+			builder: limit().Price("").PriceMatch(PriceMatchTypeNone),
+			errs:    []error{ErrOrderPriceRequired},
+		},
+		{
+			name:    "stop without price and stopPrice",
+			builder: limit().Type(OrderTypeStop).Price(""),
+			errs:    []error{ErrOrderPriceRequired, ErrOrderStopPriceRequired},
+		},
+		{
+			name:    "take profit market without stopPrice",
+			builder: s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeTakeProfitMarket).Quantity("1"),
+			errs:    []error{ErrOrderStopPriceRequired},
+		},
+		{
+			name:    "trailing stop without callbackRate",
+			builder: s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeTrailingStopMarket).Quantity("1"),
+			errs:    []error{ErrOrderCallbackRateRequired},
+		},
+		{
+			name: "reduceOnly with closePosition",
+			builder: s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeSell).
+				Type(OrderTypeStopMarket).StopPrice("55000").ClosePosition(true).ReduceOnly(true),
+			errs: []error{ErrOrderReduceOnlyClosePosition},
+		},
+		{
+			name:    "closePosition on limit order",
+			builder: limit().Quantity("").ClosePosition(true),
+			errs:    []error{ErrOrderClosePositionType},
+		},
+		{
+			name:    "price with priceMatch",
+			builder: limit().PriceMatch(PriceMatchTypeOpponent),
+			errs:    []error{ErrOrderPriceWithPriceMatch},
+		},
+		{
+			name: "priceMatch on market order",
+			builder: s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeMarket).
+				Quantity("1").PriceMatch(PriceMatchTypeOpponent),
+			errs: []error{ErrOrderPriceMatchType},
+		},
+		{
+			name:    "priceProtect on limit order",
+			builder: limit().PriceProtect(true),
+			errs:    []error{ErrOrderPriceProtectType},
+		},
+		{
+			name:    "workingType on limit order",
+			builder: limit().WorkingType(WorkingTypeContractPrice),
+			errs:    []error{ErrOrderWorkingTypeType},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.builder.Validate()
+		if len(tt.errs) == 0 {
+			s.r().NoError(err, tt.name)
+			continue
+		}
+		s.r().Error(err, tt.name)
+		for _, e := range tt.errs {
+			s.r().True(errors.Is(err, e), "%s: expected %v in %v", tt.name, e, err)
+		}
+		s.r().Len(err.(interface{ Unwrap() []error }).Unwrap(), len(tt.errs), tt.name)
+	}
+}
+
+func (s *orderBuilderTestSuite) TestBuild() {
+	data := []byte(`{"orderId": 1, "symbol": "BTCUSDT", "status": "NEW", "priceMatch": "QUEUE_5"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.r().Equal("QUEUE_5", r.form.Get("priceMatch"))
+		s.r().Equal("", r.form.Get("price"))
+		s.r().Equal("LIMIT", r.form.Get("type"))
+		s.r().Equal("0.01", r.form.Get("quantity"))
+	})
+	res, err := s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Quantity("0.01").PriceMatch(PriceMatchTypeQueue5).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("QUEUE_5", res.PriceMatch)
+
+	_, err = s.client.NewOrderBuilder().Symbol("BTCUSDT").Build()
+	s.r().Error(err)
+}
+
+func (s *orderBuilderTestSuite) TestValidateDefaultPositionSide() {
+	s.client.SetPositionMode(true)
+	b := s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket).Quantity("0.01")
+	s.r().ErrorIs(b.Validate(), ErrPositionSideRequired)
+
+	s.client.DefaultPositionSide = PositionSideTypeLong
+	s.r().NoError(b.Validate())
+}
+
+func (s *orderBuilderTestSuite) TestBuildNewOrderResponseType() {
+	s.mockDo([]byte(`{"orderId": 1, "symbol": "BTCUSDT", "status": "FILLED"}`), nil)
+	defer s.assertDo()
+	var form url.Values
+	s.assertReq(func(r *request) { form = r.form })
+
+	_, err := s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("0.01").NewOrderResponseType(NewOrderRespTypeRESULT).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("RESULT", form.Get("newOrderRespType"))
+}
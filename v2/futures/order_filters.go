@@ -0,0 +1,174 @@
+package futures
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// FilterError is returned by ValidateOrder when an order violates one of the symbol filters
+type FilterError struct {
+	Filter SymbolFilterType
+	Reason string
+}
+
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("%s filter failure: %s", e.Filter, e.Reason)
+}
+
+func newFilterError(filter SymbolFilterType, format string, v ...interface{}) *FilterError {
+	return &FilterError{Filter: filter, Reason: fmt.Sprintf(format, v...)}
+}
+
+// ValidateOrder check price and quantity against the filters of symbol before the order is sent, saving a -1013
+// round trip. price is ignored for MARKET, STOP_MARKET and TAKE_PROFIT_MARKET orders, the notional of those is
+// computed with the mark price. A *FilterError naming the violated filter is returned.
+func (c *Client) ValidateOrder(ctx context.Context, symbol string, price, qty decimal.Decimal, orderType OrderType) error {
+	info, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	s := info.Symbol(symbol)
+	if s == nil {
+		return fmt.Errorf("symbol %s not found in exchange info", symbol)
+	}
+	var markPrice decimal.Decimal
+	if s.PercentPriceFilter() != nil || (isMarketOrderType(orderType) && s.MinNotionalFilter() != nil) {
+		res, err := c.NewPremiumIndexService().Symbol(symbol).Do(ctx)
+		if err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			return fmt.Errorf("no premium index for symbol %s", symbol)
+		}
+		if markPrice, err = res[0].MarkPriceDecimal(); err != nil {
+			return err
+		}
+	}
+	return validateOrderFilters(s, price, qty, orderType, markPrice)
+}
+
+func isMarketOrderType(orderType OrderType) bool {
+	switch orderType {
+	case OrderTypeMarket, OrderTypeStopMarket, OrderTypeTakeProfitMarket, OrderTypeTrailingStopMarket:
+		return true
+	}
+	return false
+}
+
+// validateOrderFilters check the order against the filters of s, markPrice is only used by PERCENT_PRICE
+// and by MIN_NOTIONAL for market orders
+func validateOrderFilters(s *Symbol, price, qty decimal.Decimal, orderType OrderType, markPrice decimal.Decimal) error {
+	market := isMarketOrderType(orderType)
+	if !market {
+		if err := validatePriceFilter(s, price); err != nil {
+			return err
+		}
+		if err := validatePercentPriceFilter(s, price, markPrice); err != nil {
+			return err
+		}
+	}
+	if err := validateLotSizeFilter(s, qty, market); err != nil {
+		return err
+	}
+	notionalPrice := price
+	if market {
+		notionalPrice = markPrice
+	}
+	if f := s.MinNotionalFilter(); f != nil && f.Notional != "" {
+		minNotional, err := parseDecimal("notional", f.Notional)
+		if err != nil {
+			return err
+		}
+		if notional := qty.Mul(notionalPrice); notional.LessThan(minNotional) {
+			return newFilterError(SymbolFilterTypeMinNotional, "notional %s is below %s", notional, minNotional)
+		}
+	}
+	return nil
+}
+
+func validatePriceFilter(s *Symbol, price decimal.Decimal) error {
+	f := s.PriceFilter()
+	if f == nil {
+		return nil
+	}
+	minPrice, err := parseDecimal("minPrice", f.MinPrice)
+	if err != nil {
+		return err
+	}
+	maxPrice, err := parseDecimal("maxPrice", f.MaxPrice)
+	if err != nil {
+		return err
+	}
+	tickSize, err := parseDecimal("tickSize", f.TickSize)
+	if err != nil {
+		return err
+	}
+	switch {
+	case minPrice.IsPositive() && price.LessThan(minPrice):
+		return newFilterError(SymbolFilterTypePrice, "price %s is below minPrice %s", price, minPrice)
+	case maxPrice.IsPositive() && price.GreaterThan(maxPrice):
+		return newFilterError(SymbolFilterTypePrice, "price %s is above maxPrice %s", price, maxPrice)
+	case tickSize.IsPositive() && !price.Sub(minPrice).Mod(tickSize).IsZero():
+		return newFilterError(SymbolFilterTypePrice, "price %s is not a multiple of tickSize %s", price, tickSize)
+	}
+	return nil
+}
+
+func validatePercentPriceFilter(s *Symbol, price, markPrice decimal.Decimal) error {
+	f := s.PercentPriceFilter()
+	if f == nil || !markPrice.IsPositive() {
+		return nil
+	}
+	up, err := parseDecimal("multiplierUp", f.MultiplierUp)
+	if err != nil {
+		return err
+	}
+	down, err := parseDecimal("multiplierDown", f.MultiplierDown)
+	if err != nil {
+		return err
+	}
+	if upper := markPrice.Mul(up); price.GreaterThan(upper) {
+		return newFilterError(SymbolFilterTypePercentPrice, "price %s is above %s (mark price %s * %s)", price, upper, markPrice, up)
+	}
+	if lower := markPrice.Mul(down); price.LessThan(lower) {
+		return newFilterError(SymbolFilterTypePercentPrice, "price %s is below %s (mark price %s * %s)", price, lower, markPrice, down)
+	}
+	return nil
+}
+
+// validateLotSizeFilter check qty against MARKET_LOT_SIZE for market orders when the symbol has one, LOT_SIZE otherwise
+func validateLotSizeFilter(s *Symbol, qty decimal.Decimal, market bool) error {
+	filter := SymbolFilterTypeLotSize
+	var minQty, maxQty, stepSize string
+	if f := s.MarketLotSizeFilter(); market && f != nil {
+		filter = SymbolFilterTypeMarketLotSize
+		minQty, maxQty, stepSize = f.MinQuantity, f.MaxQuantity, f.StepSize
+	} else if f := s.LotSizeFilter(); f != nil {
+		minQty, maxQty, stepSize = f.MinQuantity, f.MaxQuantity, f.StepSize
+	} else {
+		return nil
+	}
+	minQtyDec, err := parseDecimal("minQty", minQty)
+	if err != nil {
+		return err
+	}
+	maxQtyDec, err := parseDecimal("maxQty", maxQty)
+	if err != nil {
+		return err
+	}
+	stepSizeDec, err := parseDecimal("stepSize", stepSize)
+	if err != nil {
+		return err
+	}
+	switch {
+	case qty.LessThan(minQtyDec):
+		return newFilterError(filter, "quantity %s is below minQty %s", qty, minQtyDec)
+	case maxQtyDec.IsPositive() && qty.GreaterThan(maxQtyDec):
+		return newFilterError(filter, "quantity %s is above maxQty %s", qty, maxQtyDec)
+	case stepSizeDec.IsPositive() && !qty.Sub(minQtyDec).Mod(stepSizeDec).IsZero():
+		return newFilterError(filter, "quantity %s is not a multiple of stepSize %s", qty, stepSizeDec)
+	}
+	return nil
+}
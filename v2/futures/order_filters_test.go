@@ -0,0 +1,105 @@
+package futures
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderFiltersTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderFilters(t *testing.T) {
+	suite.Run(t, new(orderFiltersTestSuite))
+}
+
+var orderFiltersExchangeInfoData = []byte(`{
+	"symbols": [
+		{
+			"symbol": "BTCUSDT",
+			"pricePrecision": 2,
+			"quantityPrecision": 3,
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+				{"filterType": "LOT_SIZE", "maxQty": "1000", "minQty": "0.001", "stepSize": "0.001"},
+				{"filterType": "MARKET_LOT_SIZE", "maxQty": "120", "minQty": "0.001", "stepSize": "0.001"},
+				{"filterType": "MIN_NOTIONAL", "notional": "100"},
+				{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": "4"}
+			]
+		}
+	]
+}`)
+
+var orderFiltersPremiumIndexData = []byte(`{"symbol": "BTCUSDT", "markPrice": "60000.00", "indexPrice": "60001.00"}`)
+
+func (s *orderFiltersTestSuite) validate(price, qty string, orderType OrderType) error {
+	s.mockDoOnce(orderFiltersExchangeInfoData, nil)
+	s.mockDoOnce(orderFiltersPremiumIndexData, nil)
+	return s.client.ValidateOrder(newContext(), "BTCUSDT", decimal.RequireFromString(price), decimal.RequireFromString(qty), orderType)
+}
+
+func (s *orderFiltersTestSuite) assertFilterError(err error, filter SymbolFilterType, reason string) {
+	var filterErr *FilterError
+	s.r().True(errors.As(err, &filterErr), "%v is not a FilterError", err)
+	s.r().Equal(filter, filterErr.Filter)
+	s.r().Equal(reason, filterErr.Reason)
+}
+
+func (s *orderFiltersTestSuite) TestValid() {
+	s.r().NoError(s.validate("60000.10", "0.010", OrderTypeLimit))
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *orderFiltersTestSuite) TestValidMarket() {
+	s.r().NoError(s.validate("0", "0.002", OrderTypeMarket))
+}
+
+func (s *orderFiltersTestSuite) TestPriceFilter() {
+	err := s.validate("500", "1", OrderTypeLimit)
+	s.assertFilterError(err, SymbolFilterTypePrice, "price 500 is below minPrice 556.8")
+	s.r().EqualError(err, "PRICE_FILTER filter failure: price 500 is below minPrice 556.8")
+
+	err = s.validate("60000.15", "0.010", OrderTypeLimit)
+	s.assertFilterError(err, SymbolFilterTypePrice, "price 60000.15 is not a multiple of tickSize 0.1")
+}
+
+func (s *orderFiltersTestSuite) TestLotSizeFilter() {
+	err := s.validate("60000", "0.0105", OrderTypeLimit)
+	s.assertFilterError(err, SymbolFilterTypeLotSize, "quantity 0.0105 is not a multiple of stepSize 0.001")
+
+	err = s.validate("60000", "1001", OrderTypeLimit)
+	s.assertFilterError(err, SymbolFilterTypeLotSize, "quantity 1001 is above maxQty 1000")
+}
+
+func (s *orderFiltersTestSuite) TestMarketLotSizeFilter() {
+	// 150 is within LOT_SIZE but above the MARKET_LOT_SIZE maxQty
+	s.r().NoError(s.validate("60000", "150", OrderTypeLimit))
+	err := s.validate("0", "150", OrderTypeMarket)
+	s.assertFilterError(err, SymbolFilterTypeMarketLotSize, "quantity 150 is above maxQty 120")
+}
+
+func (s *orderFiltersTestSuite) TestMinNotionalFilter() {
+	err := s.validate("60000", "0.001", OrderTypeLimit)
+	s.assertFilterError(err, SymbolFilterTypeMinNotional, "notional 60 is below 100")
+
+	// the notional of a market order is computed with the mark price
+	err = s.validate("0", "0.001", OrderTypeStopMarket)
+	s.assertFilterError(err, SymbolFilterTypeMinNotional, "notional 60 is below 100")
+}
+
+func (s *orderFiltersTestSuite) TestPercentPriceFilter() {
+	err := s.validate("63000.10", "0.010", OrderTypeLimit)
+	s.assertFilterError(err, SymbolFilterTypePercentPrice, "price 63000.1 is above 63000 (mark price 60000 * 1.05)")
+
+	err = s.validate("56999.90", "0.010", OrderTypeTakeProfit)
+	s.assertFilterError(err, SymbolFilterTypePercentPrice, "price 56999.9 is below 57000 (mark price 60000 * 0.95)")
+}
+
+func (s *orderFiltersTestSuite) TestUnknownSymbol() {
+	s.mockDoOnce(orderFiltersExchangeInfoData, nil)
+	err := s.client.ValidateOrder(newContext(), "ETHUSDT", decimal.NewFromInt(3000), decimal.NewFromInt(1), OrderTypeLimit)
+	s.r().EqualError(err, "symbol ETHUSDT not found in exchange info")
+}
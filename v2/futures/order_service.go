@@ -6,12 +6,27 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/coin-quant/go-aster/v2/common"
 )
 
+// MinGoodTillDateDuration is the minimum distance between now and goodTillDate accepted by the exchange
+var MinGoodTillDateDuration = 600 * time.Second
+
+// clientOrderIDPattern is the format of the client order ids accepted by the exchange
+var clientOrderIDPattern = regexp.MustCompile(`^[\.A-Z\:/a-z0-9_-]{1,36}$`)
+
+func validateClientOrderID(clientOrderID string) error {
+	if !clientOrderIDPattern.MatchString(clientOrderID) {
+		return fmt.Errorf("invalid client order id %q, want 1 to 36 characters in [.A-Z:/a-z0-9_-]", clientOrderID)
+	}
+	return nil
+}
+
 // CreateOrderService create order
 type CreateOrderService struct {
 	c                       *Client
@@ -32,6 +47,7 @@ type CreateOrderService struct {
 	newOrderRespType        NewOrderRespType
 	closePosition           *string
 	selfTradePreventionMode *SelfTradePreventionMode
+	priceMatch              *PriceMatchType
 	goodTillDate            int64
 }
 
@@ -96,7 +112,8 @@ func (s *CreateOrderService) StopPrice(stopPrice string) *CreateOrderService {
 	return s
 }
 
-// WorkingType set workingType
+// WorkingType set the price triggering the order, only supported by STOP, STOP_MARKET, TAKE_PROFIT,
+// TAKE_PROFIT_MARKET and TRAILING_STOP_MARKET orders
 func (s *CreateOrderService) WorkingType(workingType WorkingType) *CreateOrderService {
 	s.workingType = &workingType
 	return s
@@ -114,7 +131,7 @@ func (s *CreateOrderService) CallbackRate(callbackRate string) *CreateOrderServi
 	return s
 }
 
-// PriceProtect set priceProtect
+// PriceProtect set priceProtect, only supported by STOP, STOP_MARKET, TAKE_PROFIT and TAKE_PROFIT_MARKET orders
 func (s *CreateOrderService) PriceProtect(priceProtect bool) *CreateOrderService {
 	priceProtectStr := strconv.FormatBool(priceProtect)
 	s.priceProtect = &priceProtectStr
@@ -140,13 +157,71 @@ func (s *CreateOrderService) SelfTradePreventionMode(selfTradePreventionMode Sel
 	return s
 }
 
-// GoodTillDate set goodTillDate
+// PriceMatch set priceMatch
+func (s *CreateOrderService) PriceMatch(priceMatch PriceMatchType) *CreateOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// GoodTillDate set goodTillDate in milliseconds, only valid with TimeInForceTypeGTD
 func (s *CreateOrderService) GoodTillDate(goodTillDate int64) *CreateOrderService {
 	s.goodTillDate = goodTillDate
 	return s
 }
 
+// validate check parameter combinations rejected by the exchange, positionSide and timeInForce are the ones
+// sent, with the client defaults applied
+func (s *CreateOrderService) validate(positionSide *PositionSideType, timeInForce *TimeInForceType) error {
+	if err := s.c.validatePositionSide(positionSide); err != nil {
+		return err
+	}
+	if s.selfTradePreventionMode != nil {
+		switch *s.selfTradePreventionMode {
+		case SelfTradePreventionModeNone, SelfTradePreventionModeExpireTaker,
+			SelfTradePreventionModeExpireBoth, SelfTradePreventionModeExpireMaker:
+		default:
+			return fmt.Errorf("invalid selfTradePreventionMode %q", *s.selfTradePreventionMode)
+		}
+	}
+	if s.priceMatch != nil && *s.priceMatch != PriceMatchTypeNone {
+		if s.price != nil {
+			return ErrOrderPriceWithPriceMatch
+		}
+		if !priceMatchSupported(s.orderType) {
+			return ErrOrderPriceMatchType
+		}
+	}
+	if s.priceProtect != nil && !priceProtectSupported(s.orderType) {
+		return ErrOrderPriceProtectType
+	}
+	if s.workingType != nil {
+		if err := validateWorkingType(*s.workingType, s.orderType); err != nil {
+			return err
+		}
+	}
+	isGTD := timeInForce != nil && *timeInForce == TimeInForceTypeGTD
+	if s.goodTillDate == 0 {
+		if isGTD {
+			return errors.New("goodTillDate is required when timeInForce is GTD")
+		}
+		return nil
+	}
+	if !isGTD {
+		return errors.New("goodTillDate is only allowed when timeInForce is GTD")
+	}
+	minGoodTillDate := s.c.currentTime().UnixMilli() + MinGoodTillDateDuration.Milliseconds()
+	if s.goodTillDate < minGoodTillDate {
+		return fmt.Errorf("goodTillDate %d must be at least %s in the future", s.goodTillDate, MinGoodTillDateDuration)
+	}
+	return nil
+}
+
 func (s *CreateOrderService) createOrder(ctx context.Context, opts ...RequestOption) (data []byte, err error) {
+	positionSide := s.c.positionSideOrDefault(s.positionSide)
+	timeInForce := s.c.timeInForceOrDefault(s.timeInForce, s.orderType)
+	if err = s.validate(positionSide, timeInForce); err != nil {
+		return nil, err
+	}
 	param := map[string]interface{}{
 		"symbol": s.symbol,
 		"side":   s.side,
@@ -158,16 +233,16 @@ func (s *CreateOrderService) createOrder(ctx context.Context, opts ...RequestOpt
 		"params": param,
 	}
 	if s.newOrderRespType != "" {
-		m["newOrderRespType"] = s.newOrderRespType
+		param["newOrderRespType"] = s.newOrderRespType
 	}
 	if s.quantity != "" {
 		param["quantity"] = s.quantity
 	}
-	if s.positionSide != nil {
-		param["positionSide"] = *s.positionSide
+	if positionSide != nil {
+		param["positionSide"] = *positionSide
 	}
-	if s.timeInForce != nil {
-		param["timeInForce"] = *s.timeInForce
+	if timeInForce != nil {
+		param["timeInForce"] = *timeInForce
 	}
 	if s.reduceOnly != nil {
 		param["reduceOnly"] = *s.reduceOnly
@@ -177,6 +252,8 @@ func (s *CreateOrderService) createOrder(ctx context.Context, opts ...RequestOpt
 	}
 	if s.newClientOrderID != nil {
 		param["newClientOrderId"] = *s.newClientOrderID
+	} else {
+		param["newClientOrderId"] = common.GenerateSwapId()
 	}
 	if s.stopPrice != nil {
 		param["stopPrice"] = *s.stopPrice
@@ -199,10 +276,13 @@ func (s *CreateOrderService) createOrder(ctx context.Context, opts ...RequestOpt
 	if s.selfTradePreventionMode != nil {
 		param["selfTradePreventionMode"] = *s.selfTradePreventionMode
 	}
-	if s.goodTillDate > 0 && *s.timeInForce == TimeInForceTypeGTD {
-		param["goodTillDate"] = s.goodTillDate
+	if s.priceMatch != nil {
+		param["priceMatch"] = *s.priceMatch
+	}
+	if s.goodTillDate > 0 {
+		param["goodTillDate"] = strconv.FormatInt(s.goodTillDate, 10)
 	}
-	data, err = s.c.call(m, true)
+	data, err = s.c.call(ctx, m, true, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +296,7 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 		return nil, err
 	}
 	res = new(CreateOrderResponse)
-	err = json.Unmarshal(data, res)
+	err = s.c.unmarshal(data, res)
 	//res.RateLimitOrder10s = header.Get("X-Mbx-Order-Count-10s")
 	//res.RateLimitOrder1m = header.Get("X-Mbx-Order-Count-1m")
 
@@ -359,7 +439,7 @@ func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 		return nil, err
 	}
 	res = new(ModifyOrderResponse)
-	err = json.Unmarshal(data, res)
+	err = s.c.unmarshal(data, res)
 
 	if err != nil {
 		return nil, err
@@ -399,6 +479,7 @@ type ModifyOrderResponse struct {
 type ListOpenOrdersService struct {
 	c      *Client
 	symbol string
+	algo   *bool
 }
 
 // Symbol set symbol
@@ -407,6 +488,20 @@ func (s *ListOpenOrdersService) Symbol(symbol string) *ListOpenOrdersService {
 	return s
 }
 
+// AlgoOrdersOnly keep only the conditional orders in the response, see Order.IsAlgo
+func (s *ListOpenOrdersService) AlgoOrdersOnly() *ListOpenOrdersService {
+	algo := true
+	s.algo = &algo
+	return s
+}
+
+// RegularOrdersOnly keep only the non conditional orders in the response, see Order.IsAlgo
+func (s *ListOpenOrdersService) RegularOrdersOnly() *ListOpenOrdersService {
+	algo := false
+	s.algo = &algo
+	return s
+}
+
 // Do send request
 func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
 	r := &request{
@@ -422,11 +517,11 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 		return []*Order{}, err
 	}
 	res = make([]*Order, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*Order{}, err
 	}
-	return res, nil
+	return filterAlgoOrders(res, s.algo), nil
 }
 
 // GetOpenOrderService query current open order
@@ -473,7 +568,7 @@ func (s *GetOpenOrderService) Do(ctx context.Context, opts ...RequestOption) (re
 		return nil, err
 	}
 	res = new(Order)
-	err = json.Unmarshal(data, res)
+	err = s.c.unmarshal(data, res)
 	if err != nil {
 		return nil, err
 	}
@@ -506,8 +601,22 @@ func (s *GetOrderService) OrigClientOrderID(origClientOrderID string) *GetOrderS
 	return s
 }
 
-// Do send request
+// ClientOrderID query the order by the client order id it was placed with, it is sent as origClientOrderId
+// and checked by Do
+func (s *GetOrderService) ClientOrderID(clientOrderID string) *GetOrderService {
+	return s.OrigClientOrderID(clientOrderID)
+}
+
+// Do send request, exactly one of OrderID and ClientOrderID must be set
 func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	if (s.orderID == nil) == (s.origClientOrderID == nil) {
+		return nil, errors.New("exactly one of orderId and origClientOrderId must be set")
+	}
+	if s.origClientOrderID != nil {
+		if err = validateClientOrderID(*s.origClientOrderID); err != nil {
+			return nil, err
+		}
+	}
 	param := map[string]interface{}{
 		"symbol": s.symbol,
 	}
@@ -522,12 +631,12 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 	if s.origClientOrderID != nil {
 		param["origClientOrderId"] = *s.origClientOrderID
 	}
-	data, err := s.c.call(m, true)
+	data, err := s.c.call(ctx, m, true, opts...)
 	if err != nil {
 		return nil, err
 	}
 	res = new(Order)
-	err = json.Unmarshal(data, res)
+	err = s.c.unmarshal(data, res)
 	if err != nil {
 		return nil, err
 	}
@@ -565,6 +674,48 @@ type Order struct {
 	GoodTillDate            int64            `json:"goodTillDate"`
 }
 
+// IsAlgo return true for conditional orders: STOP, STOP_MARKET, TAKE_PROFIT, TAKE_PROFIT_MARKET and TRAILING_STOP_MARKET.
+// origType is used as the type of a triggered conditional order is the type of the order it was turned into.
+func (o *Order) IsAlgo() bool {
+	if o.OrigType != "" {
+		return isAlgoOrderType(o.OrigType)
+	}
+	return isAlgoOrderType(o.Type)
+}
+
+func isAlgoOrderType(orderType OrderType) bool {
+	switch orderType {
+	case OrderTypeStop, OrderTypeStopMarket, OrderTypeTakeProfit, OrderTypeTakeProfitMarket, OrderTypeTrailingStopMarket:
+		return true
+	}
+	return false
+}
+
+// PartitionOrders split orders into the conditional and the regular ones, keeping their order
+func PartitionOrders(orders []*Order) (algo, regular []*Order) {
+	algo, regular = make([]*Order, 0), make([]*Order, 0)
+	for _, o := range orders {
+		if o.IsAlgo() {
+			algo = append(algo, o)
+		} else {
+			regular = append(regular, o)
+		}
+	}
+	return algo, regular
+}
+
+// filterAlgoOrders keep the conditional orders when algo is true and the regular ones when it is false
+func filterAlgoOrders(orders []*Order, algo *bool) []*Order {
+	if algo == nil {
+		return orders
+	}
+	algoOrders, regularOrders := PartitionOrders(orders)
+	if *algo {
+		return algoOrders
+	}
+	return regularOrders
+}
+
 // ListOrdersService all account orders; active, canceled, or filled
 type ListOrdersService struct {
 	c         *Client
@@ -573,6 +724,7 @@ type ListOrdersService struct {
 	startTime *int64
 	endTime   *int64
 	limit     *int
+	algo      *bool
 }
 
 // Symbol set symbol
@@ -605,6 +757,20 @@ func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
 	return s
 }
 
+// AlgoOrdersOnly keep only the conditional orders in the response, see Order.IsAlgo
+func (s *ListOrdersService) AlgoOrdersOnly() *ListOrdersService {
+	algo := true
+	s.algo = &algo
+	return s
+}
+
+// RegularOrdersOnly keep only the non conditional orders in the response, see Order.IsAlgo
+func (s *ListOrdersService) RegularOrdersOnly() *ListOrdersService {
+	algo := false
+	s.algo = &algo
+	return s
+}
+
 // Do send request
 func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
 	r := &request{
@@ -630,11 +796,11 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 		return []*Order{}, err
 	}
 	res = make([]*Order, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*Order{}, err
 	}
-	return res, nil
+	return filterAlgoOrders(res, s.algo), nil
 }
 
 // CancelOrderService cancel an order
@@ -677,14 +843,14 @@ func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 		param["orderId"] = *s.orderID
 	}
 	if s.origClientOrderID != nil {
-		m["origClientOrderId"] = *s.origClientOrderID
+		param["origClientOrderId"] = *s.origClientOrderID
 	}
-	data, err := s.c.call(m, true)
+	data, err := s.c.call(ctx, m, true, opts...)
 	if err != nil {
 		return nil, err
 	}
 	res = new(CancelOrderResponse)
-	err = json.Unmarshal(data, res)
+	err = s.c.unmarshal(data, res)
 	if err != nil {
 		return nil, err
 	}
@@ -791,7 +957,7 @@ func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOp
 		return nil, err
 	}
 	res = make([]*CancelOrderResponse, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*CancelOrderResponse{}, err
 	}
@@ -856,7 +1022,7 @@ func (s *ListLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOp
 		return []*LiquidationOrder{}, err
 	}
 	res = make([]*LiquidationOrder, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*LiquidationOrder{}, err
 	}
@@ -943,7 +1109,7 @@ func (s *ListUserLiquidationOrdersService) Do(ctx context.Context, opts ...Reque
 		return []*UserLiquidationOrder{}, err
 	}
 	res = make([]*UserLiquidationOrder, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*UserLiquidationOrder{}, err
 	}
@@ -975,15 +1141,17 @@ type UserLiquidationOrder struct {
 }
 
 type CreateBatchOrdersService struct {
-	c      *Client
-	orders []*CreateOrderService
+	c                *Client
+	orders           []*CreateOrderService
+	newOrderRespType NewOrderRespType
 }
 
 // CreateBatchOrdersResponse contains the response from CreateBatchOrders operation
 type CreateBatchOrdersResponse struct {
 	// Total number of messages in the response
 	N int
-	// List of orders which were placed successfully which can have a length between 0 and N
+	// List of orders which were placed successfully which can have a length between 0 and N.
+	// With NewOrderRespTypeACK only the ids, status and order parameters are set.
 	Orders []*Order
 	// List of errors of length N, where each item corresponds to a nil value if
 	// the order from that specific index was placed successfully OR an non-nil *APIError if there was an error with
@@ -1003,6 +1171,12 @@ func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *Crea
 	return s
 }
 
+// NewOrderResponseType set newOrderRespType of the orders of the batch which don't set their own
+func (s *CreateBatchOrdersService) NewOrderResponseType(newOrderRespType NewOrderRespType) *CreateBatchOrdersService {
+	s.newOrderRespType = newOrderRespType
+	return s
+}
+
 func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
 	r := &request{
 		method:   http.MethodPost,
@@ -1013,18 +1187,22 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 	orders := []params{}
 	for _, order := range s.orders {
 		m := params{
-			"symbol":           order.symbol,
-			"side":             order.side,
-			"type":             order.orderType,
-			"quantity":         order.quantity,
-			"newOrderRespType": order.newOrderRespType,
+			"symbol":   order.symbol,
+			"side":     order.side,
+			"type":     order.orderType,
+			"quantity": order.quantity,
+		}
+		if order.newOrderRespType != "" {
+			m["newOrderRespType"] = order.newOrderRespType
+		} else if s.newOrderRespType != "" {
+			m["newOrderRespType"] = s.newOrderRespType
 		}
 
-		if order.positionSide != nil {
-			m["positionSide"] = *order.positionSide
+		if positionSide := s.c.positionSideOrDefault(order.positionSide); positionSide != nil {
+			m["positionSide"] = *positionSide
 		}
-		if order.timeInForce != nil {
-			m["timeInForce"] = *order.timeInForce
+		if timeInForce := s.c.timeInForceOrDefault(order.timeInForce, order.orderType); timeInForce != nil {
+			m["timeInForce"] = *timeInForce
 		}
 		if order.reduceOnly != nil {
 			m["reduceOnly"] = *order.reduceOnly
@@ -1075,7 +1253,7 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 
 	rawMessages := make([]*json.RawMessage, 0)
 
-	err = json.Unmarshal(data, &rawMessages)
+	err = s.c.unmarshal(data, &rawMessages)
 	if err != nil {
 		return &CreateBatchOrdersResponse{}, err
 	}
@@ -1157,29 +1335,37 @@ func (s *ModifyOrder) PriceMatch(priceMatch PriceMatchType) *ModifyOrder {
 	return s
 }
 
+// MaxBatchModifyOrders is the maximum number of orders modified by a single ModifyBatchOrdersService request
+const MaxBatchModifyOrders = 5
+
+// validate check the parameters required by the exchange for each modified order
+func (s *ModifyOrder) validate() error {
+	if s.orderID == nil && s.origClientOrderID == nil {
+		return errors.New("orderId or origClientOrderId is required")
+	}
+	if s.symbol == "" {
+		return ErrOrderSymbolRequired
+	}
+	if s.side == "" {
+		return ErrOrderSideRequired
+	}
+	if s.price == nil && s.priceMatch == nil && s.quantity == "" {
+		return errors.New("price, priceMatch or quantity is required")
+	}
+	return nil
+}
+
 // ModifyBatchOrdersService handles batch modification of orders
 type ModifyBatchOrdersService struct {
 	c      *Client
 	orders []*ModifyOrder
 }
 
-// CreateBatchOrdersResponse contains the response from CreateBatchOrders operation
-type ModifyBatchOrdersResponse struct {
-	// Total number of messages in the response
-	N int
-	// List of orders which were modified successfully which can have a length between 0 and N
-	Orders []*Order
-	// List of errors of length N, where each item corresponds to a nil value if
-	// the order from that specific index was placed successfully OR an non-nil *APIError if there was an error with
-	// the order at that index
-	Errors []error
-}
-
-func newModifyBatchOrdersResponse(n int) *ModifyBatchOrdersResponse {
-	return &ModifyBatchOrdersResponse{
-		N:      n,
-		Errors: make([]error, n),
-	}
+// BatchModifyResponse is the result of one order of a ModifyBatchOrdersService request,
+// either Order is set or Err hold the *common.APIError returned for that order
+type BatchModifyResponse struct {
+	Order *Order
+	Err   error
 }
 
 // OrderList set the list of ModifyOrder to be used in the ModifyBatchOrders operation
@@ -1189,90 +1375,79 @@ func (s *ModifyBatchOrdersService) OrderList(orders []*ModifyOrder) *ModifyBatch
 }
 
 // Do sends a request to modify a batch of orders.
-// It constructs the necessary parameters for each order and marshals them into a JSON payload.
-// The function returns a ModifyBatchOrdersResponse, which contains the results of the modification attempt.
-func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *ModifyBatchOrdersResponse, err error) {
-	// Create a new request with method PUT and the appropriate endpoint.
+// The orders are validated before sending, the result of each order is returned at the same index as in OrderList.
+func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []BatchModifyResponse, err error) {
+	if len(s.orders) == 0 || len(s.orders) > MaxBatchModifyOrders {
+		return nil, fmt.Errorf("batchOrders must contain 1 to %d orders, got %d", MaxBatchModifyOrders, len(s.orders))
+	}
 	r := &request{
 		method:   http.MethodPut,
 		endpoint: "/fapi/v1/batchOrders",
 		secType:  secTypeSigned,
 	}
 
-	orders := []params{}
-	// Iterate through the orders to construct parameters for each order.
-	for _, order := range s.orders {
+	orders := make([]params, 0, len(s.orders))
+	for i, order := range s.orders {
+		if err := order.validate(); err != nil {
+			return nil, fmt.Errorf("batchOrders[%d]: %w", i, err)
+		}
 		m := params{
-			"symbol":   order.symbol,
-			"side":     order.side,
-			"quantity": order.quantity,
-			"price":    order.price,
+			"symbol": order.symbol,
+			"side":   order.side,
 		}
-
 		// Convert orderID to string to avoid API error with code -1102.
 		if order.orderID != nil {
 			m["orderId"] = strconv.FormatInt(*order.orderID, 10)
 		}
 		if order.origClientOrderID != nil {
 			m["origClientOrderId"] = *order.origClientOrderID
-		} else {
-			m["newClientOrderId"] = common.GenerateSwapId()
+		}
+		if order.quantity != "" {
+			m["quantity"] = order.quantity
+		}
+		if order.price != nil {
+			m["price"] = *order.price
 		}
 		if order.priceMatch != nil {
 			m["priceMatch"] = *order.priceMatch
 		}
-
 		orders = append(orders, m)
 	}
 
-	// Marshal the orders into a JSON payload.
 	b, err := json.Marshal(orders)
 	if err != nil {
-		return &ModifyBatchOrdersResponse{}, err
+		return nil, err
 	}
-
-	// Set the marshaled orders as form parameters.
-	m := params{
+	r.setFormParams(params{
 		"batchOrders": string(b),
-	}
-	r.setFormParams(m)
+	})
 
-	// Call the API with the constructed request.
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return &ModifyBatchOrdersResponse{}, err
+		return nil, err
 	}
 
-	rawMessages := make([]*json.RawMessage, 0)
-	// Unmarshal the response into raw JSON messages.
-	err = json.Unmarshal(data, &rawMessages)
-	if err != nil {
-		return &ModifyBatchOrdersResponse{}, err
+	rawMessages := make([]json.RawMessage, 0, len(s.orders))
+	if err = s.c.unmarshal(data, &rawMessages); err != nil {
+		return nil, err
 	}
 
-	// Create a response object to hold the results.
-	batchModifyOrdersResponse := newModifyBatchOrdersResponse(len(rawMessages))
+	res = make([]BatchModifyResponse, len(rawMessages))
 	for i, j := range rawMessages {
-		// Check if the response contains an API error.
+		// an item is either an order or an API error
 		e := new(common.APIError)
-		if err := json.Unmarshal(*j, e); err != nil {
+		if err := json.Unmarshal(j, e); err != nil {
 			return nil, err
 		}
-
-		// If there's an error code or message, record it and continue.
-		if e.Code > 0 || e.Message != "" {
-			batchModifyOrdersResponse.Errors[i] = e
+		if e.IsValid() {
+			res[i].Err = e
 			continue
 		}
-
-		// Otherwise, unmarshal the order information.
 		o := new(Order)
-		if err := json.Unmarshal(*j, o); err != nil {
+		if err := json.Unmarshal(j, o); err != nil {
 			return nil, err
 		}
-
-		batchModifyOrdersResponse.Orders = append(batchModifyOrdersResponse.Orders, o)
+		res[i].Order = o
 	}
-
-	return batchModifyOrdersResponse, nil
+	return res, nil
 }
@@ -2,9 +2,14 @@ package futures
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/coin-quant/go-aster/v2/common"
 
@@ -37,7 +42,7 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 		"stopPrice": "0",
 		"symbol": "BTCUSDT",
 		"timeInForce": "GTC",
-		"type": "LIMIT",
+		"type": "STOP",
 		"updateTime": 1566818724722,
 		"workingType": "CONTRACT_PRICE",
 		"activatePrice": "1000",
@@ -50,7 +55,7 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	defer s.assertDo()
 	symbol := "BTCUSDT"
 	side := SideTypeSell
-	orderType := OrderTypeLimit
+	orderType := OrderTypeStop
 	timeInForce := TimeInForceTypeGTC
 	positionSide := PositionSideTypeBoth
 	quantity := "10"
@@ -107,7 +112,7 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 		StopPrice:        "0",
 		Symbol:           symbol,
 		TimeInForce:      TimeInForceTypeGTC,
-		Type:             OrderTypeLimit,
+		Type:             OrderTypeStop,
 		UpdateTime:       1566818724722,
 		WorkingType:      WorkingTypeContractPrice,
 		ActivatePrice:    activationPrice,
@@ -131,7 +136,7 @@ func (s *orderServiceTestSuite) TestCreateOrderId() {
 		"stopPrice": "0",
 		"symbol": "BTCUSDT",
 		"timeInForce": "GTC",
-		"type": "LIMIT",
+		"type": "STOP",
 		"updateTime": 1566818724722,
 		"workingType": "CONTRACT_PRICE",
 		"activatePrice": "1000",
@@ -144,7 +149,7 @@ func (s *orderServiceTestSuite) TestCreateOrderId() {
 	defer s.assertDo()
 	symbol := "BTCUSDT"
 	side := SideTypeSell
-	orderType := OrderTypeLimit
+	orderType := OrderTypeStop
 	timeInForce := TimeInForceTypeGTC
 	positionSide := PositionSideTypeBoth
 	quantity := "10"
@@ -170,6 +175,204 @@ func (s *orderServiceTestSuite) TestCreateOrderId() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderGoodTillDate() {
+	goodTillDate := time.Now().Add(time.Hour).UnixMilli()
+	data := []byte(fmt.Sprintf(`{
+		"orderId": 22542179,
+		"symbol": "BTCUSDT",
+		"status": "NEW",
+		"timeInForce": "GTD",
+		"type": "LIMIT",
+		"goodTillDate": %d
+	}`, goodTillDate))
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.r().Equal("GTD", r.form.Get("timeInForce"))
+		s.r().Equal(strconv.FormatInt(goodTillDate, 10), r.form.Get("goodTillDate"))
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTD).GoodTillDate(goodTillDate).
+		Quantity("1").Price("10000").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(TimeInForceTypeGTD, res.TimeInForce)
+	s.r().Equal(goodTillDate, res.GoodTillDate)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderGoodTillDateInvalid() {
+	future := time.Now().Add(time.Hour).UnixMilli()
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).GoodTillDate(future).
+		Quantity("1").Price("10000").Do(newContext())
+	s.r().EqualError(err, "goodTillDate is only allowed when timeInForce is GTD")
+
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTD).
+		Quantity("1").Price("10000").Do(newContext())
+	s.r().EqualError(err, "goodTillDate is required when timeInForce is GTD")
+
+	tooSoon := time.Now().Add(time.Minute).UnixMilli()
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTD).GoodTillDate(tooSoon).
+		Quantity("1").Price("10000").Do(newContext())
+	s.r().Error(err)
+	s.r().Contains(err.Error(), "must be at least 10m0s in the future")
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderGoodTillDateClientClock() {
+	now := time.Now().Add(24 * time.Hour)
+	s.client.now = func() time.Time { return now }
+	// an hour ahead of the real time is already past for the clock of the client
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTD).GoodTillDate(time.Now().Add(time.Hour).UnixMilli()).
+		Quantity("1").Price("10000").Do(newContext())
+	s.r().ErrorContains(err, "must be at least 10m0s in the future")
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderSelfTradePreventionMode() {
+	data := []byte(`{
+		"orderId": 22542179,
+		"symbol": "BTCUSDT",
+		"status": "NEW",
+		"selfTradePreventionMode": "EXPIRE_MAKER"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.r().Equal("EXPIRE_MAKER", r.form.Get("selfTradePreventionMode"))
+		s.r().NotEmpty(r.form.Get("signature"))
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("10000").
+		SelfTradePreventionMode(SelfTradePreventionModeExpireMaker).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("EXPIRE_MAKER", res.SelfTradePreventionMode)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderSelfTradePreventionModeInvalid() {
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("10000").
+		SelfTradePreventionMode("EXPIRE_ALL").Do(newContext())
+	s.r().EqualError(err, `invalid selfTradePreventionMode "EXPIRE_ALL"`)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderPriceMatch() {
+	data := []byte(`{
+		"orderId": 22542179,
+		"symbol": "BTCUSDT",
+		"status": "NEW",
+		"type": "LIMIT",
+		"priceMatch": "OPPONENT_5"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.r().Equal("OPPONENT_5", r.form.Get("priceMatch"))
+		s.r().False(r.form.Has("price"))
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").
+		PriceMatch(PriceMatchTypeOpponent5).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("OPPONENT_5", res.PriceMatch)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderPriceMatchInvalid() {
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("10000").
+		PriceMatch(PriceMatchTypeQueue).Do(newContext())
+	s.r().ErrorIs(err, ErrOrderPriceWithPriceMatch)
+
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").PriceMatch(PriceMatchTypeQueue).Do(newContext())
+	s.r().ErrorIs(err, ErrOrderPriceMatchType)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderPriceProtect() {
+	s.mockDo([]byte(`{"orderId": 22542179, "symbol": "BTCUSDT", "status": "NEW", "type": "STOP_MARKET", "priceProtect": true}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.r().Equal("true", r.form.Get("priceProtect"))
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeStopMarket).Quantity("1").StopPrice("9000").PriceProtect(true).Do(newContext())
+	s.r().NoError(err)
+	s.r().True(res.PriceProtect)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderPriceProtectInvalid() {
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("10000").
+		PriceProtect(true).Do(newContext())
+	s.r().ErrorIs(err, ErrOrderPriceProtectType)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderWorkingType() {
+	s.mockDo([]byte(`{"orderId": 22542179, "symbol": "BTCUSDT", "status": "NEW", "type": "TRAILING_STOP_MARKET", "workingType": "MARK_PRICE"}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.r().Equal("MARK_PRICE", r.form.Get("workingType"))
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeTrailingStopMarket).Quantity("1").CallbackRate("1").
+		WorkingType(WorkingTypeMarkPrice).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(WorkingTypeMarkPrice, res.WorkingType)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderWorkingTypeInvalid() {
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").WorkingType(WorkingTypeMarkPrice).Do(newContext())
+	s.r().ErrorIs(err, ErrOrderWorkingTypeType)
+
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeStopMarket).Quantity("1").StopPrice("9000").WorkingType("LAST_PRICE").Do(newContext())
+	s.r().EqualError(err, `invalid workingType "LAST_PRICE"`)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderHedgeMode() {
+	s.client.SetPositionMode(true)
+	s.mockDoOnce([]byte(`{"orderId": 22542179, "symbol": "BTCUSDT", "status": "NEW", "positionSide": "LONG"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 22542180, "symbol": "BTCUSDT", "status": "NEW", "positionSide": "SHORT"}`), nil)
+
+	for _, positionSide := range []PositionSideType{PositionSideTypeLong, PositionSideTypeShort} {
+		_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeMarket).Quantity("1").PositionSide(positionSide).Do(newContext())
+		s.r().NoError(err)
+	}
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+	req := s.client.Calls[1].Arguments.Get(0).(*http.Request)
+	s.r().NoError(req.ParseForm())
+	s.r().Equal("SHORT", req.PostForm.Get("positionSide"))
+
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	s.r().ErrorIs(err, ErrPositionSideRequired)
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").PositionSide(PositionSideTypeBoth).Do(newContext())
+	s.r().ErrorIs(err, ErrPositionSideRequired)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderOneWayMode() {
+	s.client.SetPositionMode(false)
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeMarket).Quantity("1").PositionSide(PositionSideTypeShort).Do(newContext())
+	s.r().ErrorIs(err, ErrPositionSideOneWayMode)
+
+	_, err = s.client.NewOrderBuilder().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeMarket).
+		Quantity("1").PositionSide(PositionSideTypeLong).Build()
+	s.r().ErrorIs(err, ErrPositionSideOneWayMode)
+
+	s.client.dualSidePosition.Store(nil)
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeMarket).Quantity("1").PositionSide("NONE").Do(newContext())
+	s.r().EqualError(err, `invalid positionSide "NONE"`)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
 func (s *baseOrderTestSuite) assertCreateOrderResponseEqual(e, a *CreateOrderResponse) {
 	r := s.r()
 	r.Equal(e.ClientOrderID, a.ClientOrderID, "ClientOrderID")
@@ -378,18 +581,17 @@ func (s *orderServiceTestSuite) TestGetOrder() {
 	defer s.assertDo()
 
 	symbol := "BTCUSDT"
-	orderID := int64(1)
+	orderID := "1"
 	origClientOrderID := "myOrder1"
 	s.assertReq(func(r *request) {
 		e := newSignedRequest().setParams(params{
-			"symbol":            symbol,
-			"orderId":           orderID,
-			"origClientOrderId": origClientOrderID,
+			"symbol":  symbol,
+			"orderId": orderID,
 		})
 		s.assertRequestEqual(e, r)
 	})
 	order, err := s.client.NewGetOrderService().Symbol(symbol).
-		OrderID(orderID).OrigClientOrderID(origClientOrderID).Do(newContext())
+		OrderID(orderID).Do(newContext())
 	r := s.r()
 	r.NoError(err)
 	e := &Order{
@@ -418,6 +620,96 @@ func (s *orderServiceTestSuite) TestGetOrder() {
 	s.assertOrderEqual(e, order)
 }
 
+func (s *orderServiceTestSuite) TestGetOrderID() {
+	for _, tc := range []struct {
+		name    string
+		service func(*GetOrderService) *GetOrderService
+		key     string
+		value   string
+		unset   string
+	}{
+		{"order id", func(o *GetOrderService) *GetOrderService { return o.OrderID("1") }, "orderId", "1", "origClientOrderId"},
+		{"client order id", func(o *GetOrderService) *GetOrderService { return o.ClientOrderID("my-order_1") }, "origClientOrderId", "my-order_1", "orderId"},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.mockDo([]byte(`{"symbol": "BTCUSDT", "orderId": 1, "clientOrderId": "my-order_1"}`), nil)
+			var query url.Values
+			s.assertReq(func(r *request) {
+				query = r.query
+			})
+			order, err := tc.service(s.client.NewGetOrderService().Symbol("BTCUSDT")).Do(newContext())
+			r := s.r()
+			r.NoError(err)
+			r.Equal(int64(1), order.OrderID)
+			r.Equal(tc.value, query.Get(tc.key))
+			r.NotContains(query, tc.unset)
+		})
+	}
+}
+
+func (s *orderServiceTestSuite) TestGetOrderInvalidID() {
+	for _, tc := range []struct {
+		name    string
+		service *GetOrderService
+		err     string
+	}{
+		{"neither", s.client.NewGetOrderService(), "exactly one of orderId and origClientOrderId must be set"},
+		{"both", s.client.NewGetOrderService().OrderID("1").ClientOrderID("myOrder1"), "exactly one of orderId and origClientOrderId must be set"},
+		{"empty client order id", s.client.NewGetOrderService().ClientOrderID(""), `invalid client order id "", want 1 to 36 characters in [.A-Z:/a-z0-9_-]`},
+		{"invalid client order id", s.client.NewGetOrderService().ClientOrderID("my order"), `invalid client order id "my order", want 1 to 36 characters in [.A-Z:/a-z0-9_-]`},
+	} {
+		s.Run(tc.name, func() {
+			_, err := tc.service.Symbol("BTCUSDT").Do(newContext())
+			s.r().EqualError(err, tc.err)
+		})
+	}
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+var mixedOrdersData = []byte(`[
+	{"symbol": "BTCUSDT", "orderId": 1, "status": "NEW", "type": "LIMIT", "origType": "LIMIT"},
+	{"symbol": "BTCUSDT", "orderId": 2, "status": "NEW", "type": "STOP_MARKET", "origType": "STOP_MARKET"},
+	{"symbol": "BTCUSDT", "orderId": 3, "status": "FILLED", "type": "MARKET", "origType": "TAKE_PROFIT_MARKET"},
+	{"symbol": "BTCUSDT", "orderId": 4, "status": "FILLED", "type": "MARKET", "origType": "MARKET"},
+	{"symbol": "BTCUSDT", "orderId": 5, "status": "NEW", "type": "TRAILING_STOP_MARKET"},
+	{"symbol": "BTCUSDT", "orderId": 6, "status": "NEW", "type": "TAKE_PROFIT", "origType": "TAKE_PROFIT"},
+	{"symbol": "BTCUSDT", "orderId": 7, "status": "NEW", "type": "STOP", "origType": "STOP"}
+]`)
+
+func orderIDs(orders []*Order) []int64 {
+	ids := make([]int64, 0, len(orders))
+	for _, o := range orders {
+		ids = append(ids, o.OrderID)
+	}
+	return ids
+}
+
+func (s *orderServiceTestSuite) TestPartitionOrders() {
+	r := s.r()
+	s.mockDoOnce(mixedOrdersData, nil)
+	orders, err := s.client.NewListOrdersService().Symbol("BTCUSDT").Do(newContext())
+	r.NoError(err)
+	algo, regular := PartitionOrders(orders)
+	r.Equal([]int64{2, 3, 5, 6, 7}, orderIDs(algo))
+	r.Equal([]int64{1, 4}, orderIDs(regular))
+
+	s.mockDoOnce(mixedOrdersData, nil)
+	orders, err = s.client.NewListOrdersService().Symbol("BTCUSDT").AlgoOrdersOnly().Do(newContext())
+	r.NoError(err)
+	r.Equal([]int64{2, 3, 5, 6, 7}, orderIDs(orders))
+
+	s.mockDoOnce(mixedOrdersData, nil)
+	orders, err = s.client.NewListOpenOrdersService().Symbol("BTCUSDT").RegularOrdersOnly().Do(newContext())
+	r.NoError(err)
+	r.Equal([]int64{1, 4}, orderIDs(orders))
+
+	s.mockDoOnce(mixedOrdersData, nil)
+	orders, err = s.client.NewListOpenOrdersService().AlgoOrdersOnly().Do(newContext())
+	r.NoError(err)
+	r.Equal([]int64{2, 3, 5, 6, 7}, orderIDs(orders))
+}
+
 func (s *orderServiceTestSuite) TestListOrders() {
 	data := []byte(`[
 		{
@@ -631,7 +923,7 @@ func (s *orderServiceTestSuite) TestCancelOrder() {
 	defer s.assertDo()
 
 	symbol := "BTCUSDT"
-	orderID := int64(28)
+	orderID := "28"
 	origClientOrderID := "myOrder1"
 	s.assertReq(func(r *request) {
 		e := newSignedRequest().setFormParams(params{
@@ -672,6 +964,19 @@ func (s *orderServiceTestSuite) TestCancelOrder() {
 	s.assertCancelOrderResponseEqual(e, res)
 }
 
+func (s *orderServiceTestSuite) TestCancelOrderByClientOrderID() {
+	s.mockDo([]byte(`{"clientOrderId": "myOrder1", "orderId": 283194212, "status": "CANCELED"}`), nil)
+	defer s.assertDo()
+	var query url.Values
+	s.assertReq(func(r *request) { query = r.query })
+
+	res, err := s.client.NewCancelOrderService().Symbol("BTCUSDT").OrigClientOrderID("myOrder1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("myOrder1", query.Get("origClientOrderId"))
+	s.r().False(query.Has("orderId"))
+	s.r().Equal(OrderStatusTypeCanceled, res.Status)
+}
+
 func (s *orderServiceTestSuite) assertCancelOrderResponseEqual(e, a *CancelOrderResponse) {
 	r := s.r()
 	r.Equal(e.ClientOrderID, a.ClientOrderID, "ClientOrderID")
@@ -785,6 +1090,126 @@ func (s *orderServiceTestSuite) assertLiquidationEqual(e, a *LiquidationOrder) {
 	r.Equal(e.Side, a.Side, "Side")
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderClientDefaults() {
+	s.client.DefaultPositionSide = PositionSideTypeLong
+	s.client.DefaultTimeInForce = TimeInForceTypeGTX
+	for i := 0; i < 3; i++ {
+		s.mockDoOnce([]byte(`{"orderId": 1, "status": "NEW"}`), nil)
+	}
+	var form url.Values
+	s.assertReq(func(r *request) { form = r.form })
+
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		Quantity("1").Price("60000").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("LONG", form.Get("positionSide"))
+	s.r().Equal("GTX", form.Get("timeInForce"))
+
+	// explicit values override the defaults
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeLimit).
+		Quantity("1").Price("60000").PositionSide(PositionSideTypeShort).TimeInForce(TimeInForceTypeIOC).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("SHORT", form.Get("positionSide"))
+	s.r().Equal("IOC", form.Get("timeInForce"))
+
+	// MARKET orders don't take a timeInForce
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("LONG", form.Get("positionSide"))
+	s.r().False(form.Has("timeInForce"))
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderReusedWithClientDefaults() {
+	s.client.DefaultPositionSide = PositionSideTypeLong
+	s.client.DefaultTimeInForce = TimeInForceTypeGTX
+	s.mockDoOnce([]byte(`{"orderId": 1, "status": "NEW"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 2, "status": "NEW"}`), nil)
+	var form url.Values
+	s.assertReq(func(r *request) { form = r.form })
+
+	service := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		Quantity("1").Price("60000")
+	_, err := service.Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("LONG", form.Get("positionSide"))
+	s.r().Equal("GTX", form.Get("timeInForce"))
+	s.r().Nil(service.positionSide)
+	s.r().Nil(service.timeInForce)
+
+	// the service is sent again with the defaults of the client at that time
+	s.client.DefaultPositionSide = PositionSideTypeShort
+	s.client.DefaultTimeInForce = TimeInForceTypeGTC
+	_, err = service.Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("SHORT", form.Get("positionSide"))
+	s.r().Equal("GTC", form.Get("timeInForce"))
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrdersClientDefaults() {
+	s.client.DefaultPositionSide = PositionSideTypeShort
+	s.client.DefaultTimeInForce = TimeInForceTypeGTC
+	s.mockDo([]byte(`[{"orderId": 1, "status": "NEW"}, {"orderId": 2, "status": "NEW"}]`), nil)
+	var batch []map[string]interface{}
+	s.assertReq(func(r *request) {
+		s.r().NoError(json.Unmarshal([]byte(r.form.Get("batchOrders")), &batch))
+	})
+
+	_, err := s.client.NewCreateBatchOrdersService().OrderList([]*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeLimit).
+			Quantity("1").Price("60000"),
+		s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeStopMarket).
+			Quantity("1").StopPrice("65000").PositionSide(PositionSideTypeLong),
+	}).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(batch, 2)
+	s.r().Equal("SHORT", batch[0]["positionSide"])
+	s.r().Equal("GTC", batch[0]["timeInForce"])
+	s.r().Equal("LONG", batch[1]["positionSide"])
+	s.r().NotContains(batch[1], "timeInForce")
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrdersACK() {
+	data := []byte(`[
+		{"orderId": 22542179, "symbol": "BTCUSDT", "status": "NEW", "clientOrderId": "order1", "price": "100",
+			"origQty": "10", "side": "BUY", "type": "LIMIT", "updateTime": 1566818724722},
+		{"code": -2022, "msg": "ReduceOnly Order is rejected."}
+	]`)
+	s.mockDo(data, nil)
+	var batch []map[string]interface{}
+	s.assertReq(func(r *request) {
+		s.r().NoError(json.Unmarshal([]byte(r.form.Get("batchOrders")), &batch))
+	})
+
+	res, err := s.client.NewCreateBatchOrdersService().OrderList([]*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+			Quantity("10").Price("100").NewClientOrderID("order1"),
+		s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeMarket).
+			Quantity("10").ReduceOnly(true).NewOrderResponseType(NewOrderRespTypeRESULT),
+	}).NewOrderResponseType(NewOrderRespTypeACK).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(batch, 2)
+	r.Equal("ACK", batch[0]["newOrderRespType"])
+	r.Equal("RESULT", batch[1]["newOrderRespType"])
+
+	r.Equal(2, res.N)
+	r.Len(res.Orders, 1)
+	r.Equal(&Order{
+		Symbol:        "BTCUSDT",
+		OrderID:       22542179,
+		ClientOrderID: "order1",
+		Price:         "100",
+		OrigQuantity:  "10",
+		Status:        OrderStatusTypeNew,
+		Type:          OrderTypeLimit,
+		Side:          SideTypeBuy,
+		UpdateTime:    1566818724722,
+	}, res.Orders[0])
+	r.Nil(res.Errors[0])
+	r.EqualError(res.Errors[1], "<APIError> code=-2022, msg=ReduceOnly Order is rejected.")
+}
+
 func (s *orderServiceTestSuite) TestCreateBatchOrders() {
 	data := []byte(`[
 		{
@@ -934,7 +1359,8 @@ func (s *orderServiceTestSuite) TestModifyBatchOrders() {
 	r := s.r()
 	r.NoError(err)
 
-	r.Equal(1, len(res.Orders))
+	r.Len(res, 2)
+	r.NoError(res[0].Err)
 
 	e := &Order{
 		Symbol:                  "BTCUSDT",
@@ -965,17 +1391,35 @@ func (s *orderServiceTestSuite) TestModifyBatchOrders() {
 		SelfTradePreventionMode: "NONE",
 		GoodTillDate:            0,
 	}
-	s.assertOrderEqual(e, res.Orders[0])
+	s.assertOrderEqual(e, res[0].Order)
 
-	r.Equal(
-		[]error{
-			nil,
-			&common.APIError{
-				Code:     -1102,
-				Message:  "Mandatory parameter 'price' was not sent, was empty/null, or malformed.",
-				Response: nil,
-			},
-		},
-		res.Errors)
+	r.Nil(res[1].Order)
+	r.Equal(&common.APIError{
+		Code:    -1102,
+		Message: "Mandatory parameter 'price' was not sent, was empty/null, or malformed.",
+	}, res[1].Err)
 
 }
+
+func (s *orderServiceTestSuite) TestModifyBatchOrdersInvalid() {
+	valid := func() *ModifyOrder {
+		return new(ModifyOrder).Symbol("BTCUSDT").OrderID(1).Side(SideTypeBuy).Price("100")
+	}
+	tests := []struct {
+		orders []*ModifyOrder
+		err    string
+	}{
+		{nil, "batchOrders must contain 1 to 5 orders, got 0"},
+		{[]*ModifyOrder{valid(), valid(), valid(), valid(), valid(), valid()}, "batchOrders must contain 1 to 5 orders, got 6"},
+		{[]*ModifyOrder{valid(), new(ModifyOrder).Symbol("BTCUSDT").Side(SideTypeBuy).Price("100")},
+			"batchOrders[1]: orderId or origClientOrderId is required"},
+		{[]*ModifyOrder{valid().Side("")}, "batchOrders[0]: side is required"},
+		{[]*ModifyOrder{new(ModifyOrder).Symbol("BTCUSDT").OrigClientOrderID("abc").Side(SideTypeSell)},
+			"batchOrders[0]: price, priceMatch or quantity is required"},
+	}
+	for _, tt := range tests {
+		_, err := s.client.NewModifyBatchOrdersService().OrderList(tt.orders).Do(newContext())
+		s.r().EqualError(err, tt.err)
+	}
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
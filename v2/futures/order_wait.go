@@ -0,0 +1,56 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coin-quant/go-aster/v2/common"
+	"github.com/jpillora/backoff"
+)
+
+var (
+	// WaitOrderRetryMinInterval is the delay before the first retry of WaitOrder
+	WaitOrderRetryMinInterval = 100 * time.Millisecond
+	// WaitOrderRetryMaxInterval caps the delay between WaitOrder retries
+	WaitOrderRetryMaxInterval = time.Second
+)
+
+// ErrWaitOrderTimeout is returned by WaitOrder when the order is still not found at the deadline
+var ErrWaitOrderTimeout = errors.New("order not found before deadline")
+
+// errCodeOrderNotFound is the exchange error code of an unknown order
+const errCodeOrderNotFound = -2013
+
+// WaitOrder query the order until it is found or timeout elapses. A just created order may not be queryable yet,
+// "order does not exist" errors are retried with backoff, rate limited requests after their Retry-After delay,
+// other errors are returned immediately.
+func (s *GetOrderService) WaitOrder(ctx context.Context, timeout time.Duration, opts ...RequestOption) (*Order, error) {
+	b := &backoff.Backoff{
+		Min:    WaitOrderRetryMinInterval,
+		Max:    WaitOrderRetryMaxInterval,
+		Factor: 2,
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for {
+		order, err := s.Do(ctx, opts...)
+		if err == nil {
+			return order, nil
+		}
+		var apiErr *common.APIError
+		if !errors.As(err, &apiErr) || (apiErr.Code != errCodeOrderNotFound && !common.IsRateLimited(err)) {
+			return nil, err
+		}
+		delay := retryDelay(err, b.Duration())
+		s.c.debug("order %s not found yet: %v, retry in %s", s.symbol, err, delay)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline.C:
+			return nil, fmt.Errorf("%w after %s: %w", ErrWaitOrderTimeout, timeout, err)
+		case <-time.After(delay):
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package futures
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/coin-quant/go-aster/v2/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderWaitTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderWait(t *testing.T) {
+	suite.Run(t, new(orderWaitTestSuite))
+}
+
+func (s *orderWaitTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	minInterval, maxInterval := WaitOrderRetryMinInterval, WaitOrderRetryMaxInterval
+	WaitOrderRetryMinInterval, WaitOrderRetryMaxInterval = time.Millisecond, time.Millisecond
+	s.T().Cleanup(func() {
+		WaitOrderRetryMinInterval, WaitOrderRetryMaxInterval = minInterval, maxInterval
+	})
+}
+
+var orderNotFoundData = []byte(`{"code": -2013, "msg": "Order does not exist."}`)
+
+func (s *orderWaitTestSuite) TestWaitOrder() {
+	s.mockDoOnce(orderNotFoundData, nil, http.StatusNotFound)
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "orderId": 1573346959, "clientOrderId": "abc", "status": "NEW"}`), nil)
+
+	order, err := s.client.NewGetOrderService().Symbol("BTCUSDT").OrderID("1573346959").
+		WaitOrder(newContext(), time.Second)
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1573346959), order.OrderID)
+	r.Equal(OrderStatusTypeNew, order.Status)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *orderWaitTestSuite) TestWaitOrderTimeout() {
+	// a fresh body is served for every retry
+	res := newHTTPResponse(nil, http.StatusNotFound)
+	s.client.Client.do = s.client.do
+	s.client.HTTPClient.Transport = roundTripperFunc(s.client.do)
+	s.client.On("do", anyHTTPRequest()).Return(res, nil).Run(func(mock.Arguments) {
+		res.Body = io.NopCloser(bytes.NewReader(orderNotFoundData))
+	})
+
+	_, err := s.client.NewGetOrderService().Symbol("BTCUSDT").OrderID("1").
+		WaitOrder(newContext(), 20*time.Millisecond)
+	r := s.r()
+	r.ErrorIs(err, ErrWaitOrderTimeout)
+	var apiErr *common.APIError
+	r.ErrorAs(err, &apiErr)
+	r.Equal(int64(-2013), apiErr.Code)
+}
+
+func (s *orderWaitTestSuite) TestWaitOrderOtherError() {
+	s.mockDoOnce([]byte(`{"code": -1121, "msg": "Invalid symbol."}`), nil, http.StatusBadRequest)
+
+	_, err := s.client.NewGetOrderService().Symbol("XXX").OrderID("1").
+		WaitOrder(newContext(), time.Second)
+	s.r().EqualError(err, "<APIError> code=-1121, msg=Invalid symbol.")
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
@@ -0,0 +1,180 @@
+package futures
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// GoodTillDateGrace is the delay after goodTillDate before WatchGoodTillDate cancels a GTD order
+// the exchange has not expired yet
+var GoodTillDateGrace = 5 * time.Second
+
+// OrderWatcher follow a single order on the user data stream until it reaches a final state.
+// Feed it with Handle, e.g. WsUserDataServe(listenKey, watcher.Handle, errHandler).
+type OrderWatcher struct {
+	orderID       int64
+	clientOrderID string
+	onPartialFill func(update *WsOrderTradeUpdate)
+	after         func(time.Duration) <-chan time.Time
+
+	mu    sync.Mutex
+	order *Order
+	done  chan struct{}
+}
+
+// NewOrderWatcher init an order watcher, set OrderID or ClientOrderID before feeding events
+func NewOrderWatcher() *OrderWatcher {
+	return &OrderWatcher{done: make(chan struct{}), after: time.After}
+}
+
+// OrderID set orderId of the watched order
+func (w *OrderWatcher) OrderID(orderID int64) *OrderWatcher {
+	w.orderID = orderID
+	return w
+}
+
+// ClientOrderID set clientOrderId of the watched order
+func (w *OrderWatcher) ClientOrderID(clientOrderID string) *OrderWatcher {
+	w.clientOrderID = clientOrderID
+	return w
+}
+
+// OnPartialFill set callback fired on every PARTIALLY_FILLED update of the watched order
+func (w *OrderWatcher) OnPartialFill(f func(update *WsOrderTradeUpdate)) *OrderWatcher {
+	w.onPartialFill = f
+	return w
+}
+
+// Handle consume a user data event, events of other orders are ignored
+func (w *OrderWatcher) Handle(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeOrderTradeUpdate {
+		return
+	}
+	w.HandleOrderTradeUpdate(&event.OrderTradeUpdate)
+}
+
+// HandleOrderTradeUpdate consume an order trade update, updates of other orders are ignored
+func (w *OrderWatcher) HandleOrderTradeUpdate(update *WsOrderTradeUpdate) {
+	if !w.match(update) {
+		return
+	}
+	w.mu.Lock()
+	select {
+	case <-w.done:
+		w.mu.Unlock()
+		return
+	default:
+	}
+	w.order = orderFromTradeUpdate(update)
+	final := isFinalOrderStatus(update.Status)
+	if final {
+		close(w.done)
+	}
+	w.mu.Unlock()
+
+	if update.Status == OrderStatusTypePartiallyFilled && w.onPartialFill != nil {
+		w.onPartialFill(update)
+	}
+}
+
+func (w *OrderWatcher) match(update *WsOrderTradeUpdate) bool {
+	if w.orderID != 0 {
+		return update.ID == w.orderID
+	}
+	return w.clientOrderID != "" && update.ClientOrderID == w.clientOrderID
+}
+
+// Order return the latest known state of the watched order, nil if no update was received yet
+func (w *OrderWatcher) Order() *Order {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.order
+}
+
+// WaitFill block until the order is FILLED, CANCELED, EXPIRED or REJECTED and return its final state.
+// If ctx is done first, the latest known state is returned with ctx.Err().
+func (w *OrderWatcher) WaitFill(ctx context.Context) (*Order, error) {
+	select {
+	case <-w.done:
+		return w.Order(), nil
+	case <-ctx.Done():
+		return w.Order(), ctx.Err()
+	}
+}
+
+// WatchGoodTillDate start a watchdog canceling the watched GTD order client-side when it is still open
+// grace after goodTillDate (in milliseconds), GoodTillDateGrace is used when grace is not positive.
+// The watchdog stops without canceling when the order reaches a final state first. The returned channel
+// receive the error of the cancel request, or ctx.Err(), and is closed when the watchdog stops.
+func (w *OrderWatcher) WatchGoodTillDate(ctx context.Context, c *Client, symbol string, goodTillDate int64, grace time.Duration) <-chan error {
+	if grace <= 0 {
+		grace = GoodTillDateGrace
+	}
+	errC := make(chan error, 1)
+	go func() {
+		defer close(errC)
+		deadline := time.UnixMilli(goodTillDate).Add(grace)
+		select {
+		case <-w.after(deadline.Sub(c.currentTime())):
+		case <-w.done:
+			return
+		case <-ctx.Done():
+			errC <- ctx.Err()
+			return
+		}
+		select {
+		case <-w.done:
+			return
+		default:
+		}
+		s := c.NewCancelOrderService().Symbol(symbol)
+		if w.orderID != 0 {
+			s.OrderID(strconv.FormatInt(w.orderID, 10))
+		} else {
+			s.OrigClientOrderID(w.clientOrderID)
+		}
+		_, err := s.Do(ctx)
+		errC <- err
+	}()
+	return errC
+}
+
+func isFinalOrderStatus(status OrderStatusType) bool {
+	switch status {
+	case OrderStatusTypeFilled, OrderStatusTypeCanceled, OrderStatusTypeExpired, OrderStatusTypeRejected:
+		return true
+	}
+	return false
+}
+
+func orderFromTradeUpdate(u *WsOrderTradeUpdate) *Order {
+	return &Order{
+		Symbol:                  u.Symbol,
+		OrderID:                 u.ID,
+		ClientOrderID:           u.ClientOrderID,
+		Price:                   u.OriginalPrice,
+		ReduceOnly:              u.IsReduceOnly,
+		OrigQuantity:            u.OriginalQty,
+		ExecutedQuantity:        u.AccumulatedFilledQty,
+		CumQuantity:             u.AccumulatedFilledQty,
+		Status:                  u.Status,
+		TimeInForce:             u.TimeInForce,
+		Type:                    u.Type,
+		Side:                    u.Side,
+		StopPrice:               u.StopPrice,
+		UpdateTime:              u.TradeTime,
+		WorkingType:             u.WorkingType,
+		ActivatePrice:           u.ActivationPrice,
+		PriceRate:               u.CallbackRate,
+		AvgPrice:                u.AveragePrice,
+		OrigType:                u.OriginalType,
+		PositionSide:            u.PositionSide,
+		PriceProtect:            u.PriceProtect,
+		ClosePosition:           u.IsClosingPosition,
+		PriceMatch:              u.PriceMode,
+		SelfTradePreventionMode: u.STP,
+		GoodTillDate:            u.GTD,
+	}
+}
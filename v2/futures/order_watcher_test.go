@@ -0,0 +1,143 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderWatcherTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderWatcher(t *testing.T) {
+	suite.Run(t, new(orderWatcherTestSuite))
+}
+
+func orderTradeUpdateData(orderID int64, clientOrderID string, status OrderStatusType, lastQty, cumQty string) []byte {
+	return []byte(fmt.Sprintf(`{
+		"e": "ORDER_TRADE_UPDATE",
+		"E": 1712730000100,
+		"T": 1712730000098,
+		"o": {
+			"s": "BTCUSDT",
+			"c": "%s",
+			"S": "BUY",
+			"o": "LIMIT",
+			"f": "GTC",
+			"q": "0.010",
+			"p": "60000",
+			"ap": "60000",
+			"sp": "0",
+			"x": "TRADE",
+			"X": "%s",
+			"i": %d,
+			"l": "%s",
+			"z": "%s",
+			"L": "60000",
+			"T": 1712730000098,
+			"t": 1,
+			"m": true,
+			"R": false,
+			"wt": "CONTRACT_PRICE",
+			"ot": "LIMIT",
+			"ps": "BOTH"
+		}
+	}`, clientOrderID, status, orderID, lastQty, cumQty))
+}
+
+func (s *orderWatcherTestSuite) replay(w *OrderWatcher, frames ...[]byte) {
+	for _, frame := range frames {
+		event := new(WsUserDataEvent)
+		s.r().NoError(json.Unmarshal(frame, event))
+		w.Handle(event)
+	}
+}
+
+func (s *orderWatcherTestSuite) TestWaitFill() {
+	var progress []string
+	w := NewOrderWatcher().ClientOrderID("myOrder1").OnPartialFill(func(update *WsOrderTradeUpdate) {
+		progress = append(progress, update.AccumulatedFilledQty)
+	})
+
+	go s.replay(w,
+		orderTradeUpdateData(8886774, "myOrder1", OrderStatusTypeNew, "0", "0"),
+		orderTradeUpdateData(9999999, "otherOrder", OrderStatusTypeFilled, "0.010", "0.010"),
+		orderTradeUpdateData(8886774, "myOrder1", OrderStatusTypePartiallyFilled, "0.004", "0.004"),
+		orderTradeUpdateData(8886774, "myOrder1", OrderStatusTypePartiallyFilled, "0.003", "0.007"),
+		orderTradeUpdateData(8886774, "myOrder1", OrderStatusTypeFilled, "0.003", "0.010"),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	order, err := w.WaitFill(ctx)
+	s.r().NoError(err)
+	s.r().Equal(int64(8886774), order.OrderID)
+	s.r().Equal(OrderStatusTypeFilled, order.Status)
+	s.r().Equal("0.010", order.ExecutedQuantity)
+	s.r().Equal([]string{"0.004", "0.007"}, progress)
+}
+
+func (s *orderWatcherTestSuite) TestWaitFillByOrderID() {
+	w := NewOrderWatcher().OrderID(8886774)
+	s.replay(w,
+		orderTradeUpdateData(8886774, "myOrder1", OrderStatusTypeNew, "0", "0"),
+		orderTradeUpdateData(8886774, "myOrder1", OrderStatusTypeCanceled, "0", "0"),
+		orderTradeUpdateData(8886774, "myOrder1", OrderStatusTypeFilled, "0.010", "0.010"),
+	)
+	order, err := w.WaitFill(newContext())
+	s.r().NoError(err)
+	s.r().Equal(OrderStatusTypeCanceled, order.Status)
+}
+
+func (s *orderWatcherTestSuite) TestWaitFillContextDone() {
+	w := NewOrderWatcher().OrderID(8886774)
+	s.replay(w, orderTradeUpdateData(8886774, "myOrder1", OrderStatusTypePartiallyFilled, "0.004", "0.004"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	order, err := w.WaitFill(ctx)
+	s.r().ErrorIs(err, context.DeadlineExceeded)
+	s.r().Equal(OrderStatusTypePartiallyFilled, order.Status)
+}
+
+func (s *orderWatcherTestSuite) TestWatchGoodTillDate() {
+	now := time.UnixMilli(1712730000000)
+	s.client.now = func() time.Time { return now }
+	s.mockDoOnce([]byte(`{"orderId": 42, "status": "CANCELED"}`), nil)
+	var params *request
+	s.assertReq(func(r *request) { params = r })
+
+	var waits []time.Duration
+	w := NewOrderWatcher().OrderID(42)
+	w.after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		c := make(chan time.Time, 1)
+		c <- now.Add(d)
+		return c
+	}
+	// the order isn't expired server-side, the watchdog cancels it once the deadline is passed
+	s.replay(w, orderTradeUpdateData(42, "gtd", OrderStatusTypeNew, "0", "0"))
+	err, ok := <-w.WatchGoodTillDate(newContext(), s.client.Client, "BTCUSDT", now.Add(time.Minute).UnixMilli(), 2*time.Second)
+	s.r().True(ok)
+	s.r().NoError(err)
+	s.r().Equal([]time.Duration{time.Minute + 2*time.Second}, waits)
+	s.r().Equal("42", params.query.Get("orderId"))
+	s.r().Equal("BTCUSDT", params.query.Get("symbol"))
+}
+
+func (s *orderWatcherTestSuite) TestWatchGoodTillDateExpired() {
+	s.mockDo([]byte(`{}`), nil)
+	w := NewOrderWatcher().ClientOrderID("gtd")
+	w.after = func(time.Duration) <-chan time.Time { return make(chan time.Time) }
+	errC := w.WatchGoodTillDate(newContext(), s.client.Client, "BTCUSDT", time.Now().Add(time.Minute).UnixMilli(), 0)
+
+	s.replay(w, orderTradeUpdateData(42, "gtd", OrderStatusTypeExpired, "0", "0"))
+	_, ok := <-errC
+	s.r().False(ok)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
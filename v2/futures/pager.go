@@ -0,0 +1,36 @@
+package futures
+
+import (
+	"context"
+)
+
+// pager implement Next and Err of the iterators paging through rows of type T, the iterators only
+// keep the cursor of their requests. nextPage fetch the rows of the next page not returned yet,
+// last is true when no page follows.
+type pager[T any] struct {
+	nextPage func(ctx context.Context) (rows []T, last bool, err error)
+
+	buf     []T
+	current T
+	done    bool
+	err     error
+}
+
+// Next advance to the next row, it returns false when the rows are consumed or on error
+func (p *pager[T]) Next(ctx context.Context) bool {
+	for len(p.buf) == 0 {
+		if p.done || p.err != nil {
+			var zero T
+			p.current = zero
+			return false
+		}
+		p.buf, p.done, p.err = p.nextPage(ctx)
+	}
+	p.current, p.buf = p.buf[0], p.buf[1:]
+	return true
+}
+
+// Err return the error which stopped the iteration
+func (p *pager[T]) Err() error {
+	return p.err
+}
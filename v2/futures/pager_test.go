@@ -0,0 +1,63 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pageData return a JSON array of one row per value, formatted with format
+func pageData(format string, values ...int64) []byte {
+	rows := make([]string, 0, len(values))
+	for _, v := range values {
+		rows = append(rows, fmt.Sprintf(format, v))
+	}
+	return []byte("[" + strings.Join(rows, ",") + "]")
+}
+
+// collect drain p and return the key of every row
+func collect[T any](p *pager[T], key func(T) int64) []int64 {
+	var keys []int64
+	for p.Next(newContext()) {
+		keys = append(keys, key(p.current))
+	}
+	return keys
+}
+
+func TestPager(t *testing.T) {
+	pages := [][]int64{{1, 2}, nil, {3}}
+	fetches := 0
+	p := &pager[int64]{nextPage: func(ctx context.Context) ([]int64, bool, error) {
+		page := pages[fetches]
+		fetches++
+		return page, fetches == len(pages), nil
+	}}
+
+	// an empty page which is not the last one is followed by the next page
+	require.Equal(t, []int64{1, 2, 3}, collect(p, func(v int64) int64 { return v }))
+	require.NoError(t, p.Err())
+	require.Equal(t, 3, fetches)
+	require.False(t, p.Next(newContext()))
+	require.Zero(t, p.current)
+	require.Equal(t, 3, fetches)
+}
+
+func TestPagerError(t *testing.T) {
+	fetches := 0
+	p := &pager[int64]{nextPage: func(ctx context.Context) ([]int64, bool, error) {
+		fetches++
+		if fetches > 1 {
+			return nil, false, errors.New("dummy error")
+		}
+		return []int64{1, 2}, false, nil
+	}}
+
+	require.Equal(t, []int64{1, 2}, collect(p, func(v int64) int64 { return v }))
+	require.EqualError(t, p.Err(), "dummy error")
+	require.False(t, p.Next(newContext()))
+	require.Equal(t, 2, fetches)
+}
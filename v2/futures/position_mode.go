@@ -0,0 +1,159 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrPositionSideRequired is returned in hedge mode when positionSide is not LONG or SHORT
+	ErrPositionSideRequired = errors.New("positionSide LONG or SHORT is required in hedge mode")
+	// ErrPositionSideOneWayMode is returned in one-way mode when positionSide is LONG or SHORT
+	ErrPositionSideOneWayMode = errors.New("positionSide must be BOTH in one-way mode")
+)
+
+// SetPositionMode let the client check positionSide against the position mode of the account before orders and
+// margin updates are sent, hedge mode when dual is true and one-way mode otherwise.
+// It may be called while requests are in flight, a successful position mode change refresh it.
+func (c *Client) SetPositionMode(dual bool) *Client {
+	c.dualSidePosition.Store(&dual)
+	return c
+}
+
+// PositionMode return the position mode known by the client, true for hedge mode, ok is false when it is unknown
+func (c *Client) PositionMode() (dual bool, ok bool) {
+	if p := c.dualSidePosition.Load(); p != nil {
+		return *p, true
+	}
+	return false, false
+}
+
+// refreshPositionMode update the position mode known by the client, it is left unknown when not set
+func (c *Client) refreshPositionMode(dual bool) {
+	for {
+		old := c.dualSidePosition.Load()
+		if old == nil || c.dualSidePosition.CompareAndSwap(old, &dual) {
+			return
+		}
+	}
+}
+
+// validatePositionSide check positionSide, nil when not set, and check it against the position mode when known.
+// In one-way mode an unset positionSide default to BOTH on the exchange.
+func (c *Client) validatePositionSide(positionSide *PositionSideType) error {
+	if positionSide != nil {
+		switch *positionSide {
+		case PositionSideTypeBoth, PositionSideTypeLong, PositionSideTypeShort:
+		default:
+			return fmt.Errorf("invalid positionSide %q", *positionSide)
+		}
+	}
+	dual, ok := c.PositionMode()
+	if !ok {
+		return nil
+	}
+	if dual {
+		if positionSide == nil || *positionSide == PositionSideTypeBoth {
+			return ErrPositionSideRequired
+		}
+		return nil
+	}
+	if positionSide != nil && *positionSide != PositionSideTypeBoth {
+		return ErrPositionSideOneWayMode
+	}
+	return nil
+}
+
+// IsHedgeMode return true when the account is in hedge mode, false in one-way mode
+func (c *Client) IsHedgeMode(ctx context.Context) (bool, error) {
+	mode, err := c.NewGetPositionModeService().Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return mode.DualSidePosition, nil
+}
+
+// PositionModeBlockedError is returned by SwitchPositionMode when open orders or positions
+// prevent the exchange from changing the position mode
+type PositionModeBlockedError struct {
+	OpenOrders map[string]int // number of open orders per symbol
+	Positions  []string       // symbols with open positions
+}
+
+// Error list the symbols blocking the change
+func (e *PositionModeBlockedError) Error() string {
+	var blockers []string
+	if len(e.OpenOrders) > 0 {
+		symbols := make([]string, 0, len(e.OpenOrders))
+		for symbol := range e.OpenOrders {
+			symbols = append(symbols, symbol)
+		}
+		sort.Strings(symbols)
+		orders := make([]string, 0, len(symbols))
+		for _, symbol := range symbols {
+			orders = append(orders, fmt.Sprintf("%s(%d)", symbol, e.OpenOrders[symbol]))
+		}
+		blockers = append(blockers, "open orders on "+strings.Join(orders, ", "))
+	}
+	if len(e.Positions) > 0 {
+		blockers = append(blockers, "open positions on "+strings.Join(e.Positions, ", "))
+	}
+	return "cannot change position mode: " + strings.Join(blockers, "; ")
+}
+
+// SwitchPositionMode change position mode to hedge mode (dual = true) or one-way mode (dual = false).
+// Open orders and positions are checked first and reported as a *PositionModeBlockedError.
+// When force is true and no position is open, open orders are cancelled before switching.
+func (c *Client) SwitchPositionMode(ctx context.Context, dual, force bool) error {
+	mode, err := c.NewGetPositionModeService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	if mode.DualSidePosition == dual {
+		c.refreshPositionMode(dual)
+		return nil
+	}
+
+	orders, err := c.NewListOpenOrdersService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	openOrders := make(map[string]int)
+	for _, order := range orders {
+		openOrders[order.Symbol]++
+	}
+
+	positions, err := c.NewGetPositionRiskService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	var openPositions []string
+	for _, pos := range positions {
+		amount, err := decimal.NewFromString(pos.PositionAmt)
+		if err != nil {
+			return fmt.Errorf("invalid positionAmt %q of %s: %w", pos.PositionAmt, pos.Symbol, err)
+		}
+		if !amount.IsZero() {
+			openPositions = append(openPositions, pos.Symbol)
+		}
+	}
+	sort.Strings(openPositions)
+
+	if force && len(openOrders) > 0 && len(openPositions) == 0 {
+		for symbol := range openOrders {
+			if err := c.NewCancelAllOpenOrdersService().Symbol(symbol).Do(ctx); err != nil {
+				return fmt.Errorf("cancel open orders of %s: %w", symbol, err)
+			}
+		}
+		openOrders = nil
+	}
+	if len(openOrders) > 0 || len(openPositions) > 0 {
+		return &PositionModeBlockedError{OpenOrders: openOrders, Positions: openPositions}
+	}
+	return c.NewChangePositionModeService().DualSide(dual).Do(ctx)
+}
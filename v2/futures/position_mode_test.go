@@ -0,0 +1,123 @@
+package futures
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type positionModeTestSuite struct {
+	baseTestSuite
+}
+
+func TestPositionMode(t *testing.T) {
+	suite.Run(t, new(positionModeTestSuite))
+}
+
+var (
+	oneWayModeData    = []byte(`{"dualSidePosition": false}`)
+	openOrdersData    = []byte(`[{"symbol": "BTCUSDT", "orderId": 1}, {"symbol": "BTCUSDT", "orderId": 2}, {"symbol": "ETHUSDT", "orderId": 3}]`)
+	noPositionsData   = []byte(`[{"symbol": "BTCUSDT", "positionAmt": "0.000"}, {"symbol": "ETHUSDT", "positionAmt": "0"}]`)
+	openPositionsData = []byte(`[{"symbol": "BTCUSDT", "positionAmt": "0.010"}, {"symbol": "ETHUSDT", "positionAmt": "-1.5"}]`)
+)
+
+func (s *positionModeTestSuite) TestSwitchPositionMode() {
+	s.mockDoOnce(oneWayModeData, nil)
+	s.mockDoOnce([]byte(`[]`), nil)
+	s.mockDoOnce(noPositionsData, nil)
+	s.mockDoOnce([]byte(`{"code": 200, "msg": "success"}`), nil)
+
+	err := s.client.SwitchPositionMode(newContext(), true, false)
+	s.r().NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+}
+
+func (s *positionModeTestSuite) TestSwitchPositionModeThenOrder() {
+	s.client.SetPositionMode(false)
+	s.mockDoOnce(oneWayModeData, nil)
+	s.mockDoOnce([]byte(`[]`), nil)
+	s.mockDoOnce(noPositionsData, nil)
+	s.mockDoOnce([]byte(`{"code": 200, "msg": "success"}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 1, "symbol": "BTCUSDT", "status": "NEW", "positionSide": "LONG"}`), nil)
+
+	s.r().NoError(s.client.SwitchPositionMode(newContext(), true, false))
+	dual, ok := s.client.PositionMode()
+	s.r().True(ok)
+	s.r().True(dual)
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket).
+		Quantity("1").PositionSide(PositionSideTypeLong).Do(newContext())
+	s.r().NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 5)
+}
+
+func (s *positionModeTestSuite) TestChangePositionModeUnknown() {
+	s.mockDoOnce([]byte(`{"code": 200, "msg": "success"}`), nil)
+
+	s.r().NoError(s.client.NewChangePositionModeService().DualSide(true).Do(newContext()))
+	_, ok := s.client.PositionMode()
+	s.r().False(ok)
+}
+
+func (s *positionModeTestSuite) TestSwitchPositionModeUnchanged() {
+	s.mockDoOnce(oneWayModeData, nil)
+
+	err := s.client.SwitchPositionMode(newContext(), false, false)
+	s.r().NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *positionModeTestSuite) TestSwitchPositionModeBlocked() {
+	s.mockDoOnce(oneWayModeData, nil)
+	s.mockDoOnce(openOrdersData, nil)
+	s.mockDoOnce(openPositionsData, nil)
+
+	err := s.client.SwitchPositionMode(newContext(), true, true)
+	s.r().EqualError(err, "cannot change position mode: open orders on BTCUSDT(2), ETHUSDT(1); open positions on BTCUSDT, ETHUSDT")
+	blocked, ok := err.(*PositionModeBlockedError)
+	s.r().True(ok)
+	s.r().Equal(map[string]int{"BTCUSDT": 2, "ETHUSDT": 1}, blocked.OpenOrders)
+	s.r().Equal([]string{"BTCUSDT", "ETHUSDT"}, blocked.Positions)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *positionModeTestSuite) TestSwitchPositionModeForce() {
+	s.mockDoOnce(oneWayModeData, nil)
+	s.mockDoOnce(openOrdersData, nil)
+	s.mockDoOnce(noPositionsData, nil)
+	s.mockDoOnce([]byte(`{"code": 200, "msg": "success"}`), nil)
+	s.mockDoOnce([]byte(`{"code": 200, "msg": "success"}`), nil)
+	s.mockDoOnce([]byte(`{"code": 200, "msg": "success"}`), nil)
+
+	err := s.client.SwitchPositionMode(newContext(), true, true)
+	s.r().NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 6)
+}
+
+func (s *positionModeTestSuite) TestIsHedgeMode() {
+	s.mockDoOnce([]byte(`{"dualSidePosition": true}`), nil)
+	s.mockDoOnce(oneWayModeData, nil)
+
+	hedge, err := s.client.IsHedgeMode(newContext())
+	s.r().NoError(err)
+	s.r().True(hedge)
+	hedge, err = s.client.IsHedgeMode(newContext())
+	s.r().NoError(err)
+	s.r().False(hedge)
+}
+
+func (s *positionModeTestSuite) TestChangePositionMode() {
+	for _, dual := range []bool{true, false} {
+		s.mockDoOnce([]byte(`{"code": 200, "msg": "success"}`), nil)
+		var sent string
+		s.assertReq(func(r *request) {
+			sent = r.form.Get("dualSidePosition")
+		})
+		s.r().NoError(s.client.NewChangePositionModeService().DualSide(dual).Do(newContext()))
+		s.r().Equal(strconv.FormatBool(dual), sent)
+	}
+
+	err := s.client.NewChangePositionModeService().Do(newContext())
+	s.r().EqualError(err, "dualSidePosition is required, see DualSide")
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
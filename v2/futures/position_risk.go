@@ -2,8 +2,11 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+
+	"github.com/shopspring/decimal"
 )
 
 // GetPositionRiskV3Service get account balance
@@ -30,12 +33,12 @@ func (s *GetPositionRiskService) Do(ctx context.Context, opts ...RequestOption)
 			"symbol": s.symbol,
 		}
 	}
-	data, err := s.c.call(m, true)
+	data, err := s.c.call(ctx, m, true, opts...)
 	if err != nil {
 		return []*PositionRisk{}, err
 	}
 	res = make([]*PositionRisk, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*PositionRisk{}, err
 	}
@@ -97,7 +100,7 @@ func (s *GetPositionRiskV3Service) Do(ctx context.Context, opts ...RequestOption
 		return []*PositionRiskV3{}, err
 	}
 	res = make([]*PositionRiskV3, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*PositionRiskV3{}, err
 	}
@@ -127,3 +130,46 @@ type PositionRiskV3 struct {
 	AskNotional            string `json:"askNotional"`
 	UpdateTime             int64  `json:"updateTime"`
 }
+
+// NotionalValue return positionAmt * markPrice, negative for a short position.
+// Unlike the Notional field it is computed locally, e.g. after MarkPrice was updated from the mark price stream.
+func (p *PositionRiskV3) NotionalValue() (decimal.Decimal, error) {
+	amt, err := parseDecimal("positionAmt", p.PositionAmt)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	markPrice, err := parseDecimal("markPrice", p.MarkPrice)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return amt.Mul(markPrice), nil
+}
+
+// InitialMarginForLeverage return the absolute notional value divided by leverage
+func (p *PositionRiskV3) InitialMarginForLeverage(leverage int) (decimal.Decimal, error) {
+	if leverage <= 0 {
+		return decimal.Zero, fmt.Errorf("invalid leverage %d", leverage)
+	}
+	notional, err := p.NotionalValue()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return notional.Abs().Div(decimal.NewFromInt(int64(leverage))), nil
+}
+
+// MarginRatio return maintMargin / (walletBalance + unRealizedProfit), the position is liquidated when it reaches 1
+func (p *PositionRiskV3) MarginRatio(walletBalance decimal.Decimal) (decimal.Decimal, error) {
+	maintMargin, err := parseDecimal("maintMargin", p.MaintMargin)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	unRealizedProfit, err := parseDecimal("unRealizedProfit", p.UnRealizedProfit)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	marginBalance := walletBalance.Add(unRealizedProfit)
+	if !marginBalance.IsPositive() {
+		return decimal.Zero, errors.New("margin balance is not positive")
+	}
+	return maintMargin.Div(marginBalance), nil
+}
@@ -3,6 +3,7 @@ package futures
 import (
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -173,3 +174,32 @@ func (s *positionRiskServiceTestSuite) assertPositionRiskEqual(e, a *PositionRis
 	r.Equal(e.AskNotional, a.AskNotional, "AskNotional")
 	r.Equal(e.UpdateTime, a.UpdateTime, "UpdateTime")
 }
+
+func (s *positionRiskServiceTestSuite) TestPositionRiskV3Margin() {
+	p := &PositionRiskV3{
+		Symbol:           "BTCUSDT",
+		PositionAmt:      "-0.5",
+		MarkPrice:        "60000",
+		UnRealizedProfit: "-250",
+		MaintMargin:      "120",
+	}
+	notional, err := p.NotionalValue()
+	s.r().NoError(err)
+	s.r().Equal("-30000", notional.String())
+
+	initialMargin, err := p.InitialMarginForLeverage(20)
+	s.r().NoError(err)
+	s.r().Equal("1500", initialMargin.String())
+	_, err = p.InitialMarginForLeverage(0)
+	s.r().EqualError(err, "invalid leverage 0")
+
+	ratio, err := p.MarginRatio(decimal.NewFromInt(1450))
+	s.r().NoError(err)
+	s.r().Equal("0.1", ratio.String())
+	_, err = p.MarginRatio(decimal.NewFromInt(250))
+	s.r().EqualError(err, "margin balance is not positive")
+
+	p.MarkPrice = ""
+	_, err = p.NotionalValue()
+	s.r().ErrorContains(err, `invalid markPrice ""`)
+}
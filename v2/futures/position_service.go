@@ -2,11 +2,18 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+
+	"github.com/shopspring/decimal"
 )
 
+// ErrMarginNotIsolated is returned by UpdatePositionMarginService when AddMargin or ReduceMargin is used
+// on a symbol in CROSSED margin mode
+var ErrMarginNotIsolated = errors.New("position margin can only be updated in ISOLATED margin mode")
+
 // ChangeLeverageService change user's initial leverage of specific symbol market
 type ChangeLeverageService struct {
 	c        *Client
@@ -36,12 +43,12 @@ func (s *ChangeLeverageService) Do(ctx context.Context, opts ...RequestOption) (
 			"leverage": s.leverage,
 		},
 	}
-	data, err := s.c.call(m, true)
+	data, err := s.c.call(ctx, m, true, opts...)
 	if err != nil {
 		return nil, err
 	}
 	res = new(SymbolLeverage)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +91,7 @@ func (s *ChangeMarginTypeService) Do(ctx context.Context, opts ...RequestOption)
 			"marginType": s.marginType,
 		},
 	}
-	_, err = s.c.call(m, true)
+	_, err = s.c.call(ctx, m, true, opts...)
 	if err != nil {
 		return err
 	}
@@ -93,11 +100,12 @@ func (s *ChangeMarginTypeService) Do(ctx context.Context, opts ...RequestOption)
 
 // UpdatePositionMarginService update isolated position margin
 type UpdatePositionMarginService struct {
-	c            *Client
-	symbol       string
-	positionSide *PositionSideType
-	amount       string
-	actionType   int
+	c             *Client
+	symbol        string
+	positionSide  *PositionSideType
+	amount        string
+	actionType    int
+	checkIsolated bool
 }
 
 // Symbol set symbol
@@ -124,8 +132,57 @@ func (s *UpdatePositionMarginService) Type(actionType int) *UpdatePositionMargin
 	return s
 }
 
+// AddMargin add amount to the isolated position margin, Do check the symbol is in ISOLATED mode first
+func (s *UpdatePositionMarginService) AddMargin(amount decimal.Decimal) *UpdatePositionMarginService {
+	return s.isolatedMargin(amount, 1)
+}
+
+// ReduceMargin remove amount from the isolated position margin, Do check the symbol is in ISOLATED mode first
+func (s *UpdatePositionMarginService) ReduceMargin(amount decimal.Decimal) *UpdatePositionMarginService {
+	return s.isolatedMargin(amount, 2)
+}
+
+func (s *UpdatePositionMarginService) isolatedMargin(amount decimal.Decimal, actionType int) *UpdatePositionMarginService {
+	s.amount = amount.String()
+	s.actionType = actionType
+	s.checkIsolated = true
+	return s
+}
+
+// validateIsolated check the amount is positive and the symbol margin type is ISOLATED
+func (s *UpdatePositionMarginService) validateIsolated(ctx context.Context) error {
+	amount, err := parseDecimal("amount", s.amount)
+	if err != nil {
+		return err
+	}
+	if !amount.IsPositive() {
+		return fmt.Errorf("amount must be positive, got %s", amount)
+	}
+	configs, err := s.c.NewGetSymbolConfigService().Symbol(s.symbol).Do(ctx)
+	if err != nil {
+		return err
+	}
+	config := configs.BySymbol(s.symbol)
+	if config == nil {
+		return fmt.Errorf("no symbol config for %s", s.symbol)
+	}
+	if config.MarginType != string(MarginTypeIsolated) {
+		return fmt.Errorf("%w: %s is %s", ErrMarginNotIsolated, s.symbol, config.MarginType)
+	}
+	return nil
+}
+
 // Do send request
 func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	positionSide := s.c.positionSideOrDefault(s.positionSide)
+	if err = s.c.validatePositionSide(positionSide); err != nil {
+		return err
+	}
+	if s.checkIsolated {
+		if err = s.validateIsolated(ctx); err != nil {
+			return err
+		}
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/positionMargin",
@@ -136,8 +193,8 @@ func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOpt
 		"amount": s.amount,
 		"type":   s.actionType,
 	}
-	if s.positionSide != nil {
-		m["positionSide"] = *s.positionSide
+	if positionSide != nil {
+		m["positionSide"] = *positionSide
 	}
 	r.setFormParams(m)
 
@@ -151,28 +208,32 @@ func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOpt
 // ChangePositionModeService change user's position mode
 type ChangePositionModeService struct {
 	c        *Client
-	dualSide bool
+	dualSide *bool
 }
 
 // Change user's position mode: true - Hedge Mode, false - One-way Mode
 func (s *ChangePositionModeService) DualSide(dualSide bool) *ChangePositionModeService {
-	s.dualSide = dualSide
+	s.dualSide = &dualSide
 	return s
 }
 
 // Do send request
 func (s *ChangePositionModeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	if s.dualSide == nil {
+		return errors.New("dualSidePosition is required, see DualSide")
+	}
 	m := map[string]interface{}{
 		"url":    "/fapi/v3/positionSide/dual",
 		"method": http.MethodPost,
 		"params": map[string]interface{}{
-			"dualSidePosition": strconv.FormatBool(s.dualSide),
+			"dualSidePosition": strconv.FormatBool(*s.dualSide),
 		},
 	}
-	_, err = s.c.call(m, true)
+	_, err = s.c.call(ctx, m, true, opts...)
 	if err != nil {
 		return err
 	}
+	s.c.refreshPositionMode(*s.dualSide)
 	return nil
 }
 
@@ -193,12 +254,12 @@ func (s *GetPositionModeService) Do(ctx context.Context, opts ...RequestOption)
 		"method": http.MethodGet,
 		"params": map[string]interface{}{},
 	}
-	data, err := s.c.call(m, true)
+	data, err := s.c.call(ctx, m, true, opts...)
 	if err != nil {
 		return nil, err
 	}
 	res = &PositionMode{}
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -208,24 +269,27 @@ func (s *GetPositionModeService) Do(ctx context.Context, opts ...RequestOption)
 // ChangeMultiAssetModeService change user's multi-asset mode
 type ChangeMultiAssetModeService struct {
 	c                 *Client
-	multiAssetsMargin bool
+	multiAssetsMargin *bool
 }
 
-// MultiAssetsMargin set multiAssetsMargin
+// MultiAssetsMargin set multiAssetsMargin: true for multi-assets mode, false for single-asset mode
 func (s *ChangeMultiAssetModeService) MultiAssetsMargin(multiAssetsMargin bool) *ChangeMultiAssetModeService {
-	s.multiAssetsMargin = multiAssetsMargin
+	s.multiAssetsMargin = &multiAssetsMargin
 	return s
 }
 
 // Do send request
 func (s *ChangeMultiAssetModeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	if s.multiAssetsMargin == nil {
+		return errors.New("multiAssetsMargin is required, see MultiAssetsMargin")
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/multiAssetsMargin",
 		secType:  secTypeSigned,
 	}
 	r.setFormParams(params{
-		"multiAssetsMargin": s.multiAssetsMargin,
+		"multiAssetsMargin": *s.multiAssetsMargin,
 	})
 	_, _, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -257,7 +321,7 @@ func (s *GetMultiAssetModeService) Do(ctx context.Context, opts ...RequestOption
 		return nil, err
 	}
 	res = &MultiAssetMode{}
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -1,8 +1,10 @@
 package futures
 
 import (
+	"strconv"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -31,7 +33,7 @@ func (s *positionServiceTestSuite) TestChangeLeverage() {
 		})
 		s.assertRequestEqual(e, r)
 	})
-	res, err := s.client.NewChangeLeverageService().Symbol(symbol).Leverage(leverage).Do(newContext())
+	res, err := s.client.NewChangeLeverageService().Symbol(symbol).Leverage(strconv.Itoa(leverage)).Do(newContext())
 	s.r().NoError(err)
 	e := &SymbolLeverage{
 		Symbol:           symbol,
@@ -91,6 +93,46 @@ func (s *positionServiceTestSuite) TestUpdatePositionMargin() {
 	s.r().NoError(err)
 }
 
+func (s *positionServiceTestSuite) TestUpdatePositionMarginOneWayMode() {
+	WithPositionMode(false)(s.client.Client)
+	err := s.client.NewUpdatePositionMarginService().Symbol("BTCUSDT").
+		PositionSide(PositionSideTypeShort).Amount("100").Type(1).Do(newContext())
+	s.r().ErrorIs(err, ErrPositionSideOneWayMode)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *positionServiceTestSuite) TestAddReduceMargin() {
+	isolated := []byte(`[{"symbol": "BTCUSDT", "marginType": "ISOLATED", "isAutoAddMargin": false, "leverage": 10, "maxNotionalValue": "1000000"}]`)
+	updated := []byte(`{"amount": 100.0, "code": 200, "msg": "Successfully modify position margin.", "type": 1}`)
+	s.mockDoOnce(isolated, nil)
+	s.mockDoOnce(updated, nil)
+	add := s.client.NewUpdatePositionMarginService().Symbol("BTCUSDT").AddMargin(decimal.NewFromInt(100))
+	s.r().NoError(add.Do(newContext()))
+	s.r().Equal(1, add.actionType)
+	s.r().Equal("100", add.amount)
+
+	s.mockDoOnce(isolated, nil)
+	s.mockDoOnce(updated, nil)
+	reduce := s.client.NewUpdatePositionMarginService().Symbol("BTCUSDT").ReduceMargin(decimal.RequireFromString("25.5"))
+	s.r().NoError(reduce.Do(newContext()))
+	s.r().Equal(2, reduce.actionType)
+	s.r().Equal("25.5", reduce.amount)
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+}
+
+func (s *positionServiceTestSuite) TestAddMarginCrossed() {
+	s.mockDoOnce([]byte(`[{"symbol": "BTCUSDT", "marginType": "CROSSED", "isAutoAddMargin": false, "leverage": 10, "maxNotionalValue": "1000000"}]`), nil)
+	err := s.client.NewUpdatePositionMarginService().Symbol("BTCUSDT").AddMargin(decimal.NewFromInt(100)).Do(newContext())
+	s.r().ErrorIs(err, ErrMarginNotIsolated)
+	s.r().EqualError(err, "position margin can only be updated in ISOLATED margin mode: BTCUSDT is CROSSED")
+	// the margin update is not sent
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+
+	err = s.client.NewUpdatePositionMarginService().Symbol("BTCUSDT").ReduceMargin(decimal.Zero).Do(newContext())
+	s.r().EqualError(err, "amount must be positive, got 0")
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
 func (s *positionServiceTestSuite) TestChangePositionMode() {
 	data := []byte(`{
 		"code": 200,
@@ -154,3 +196,30 @@ func (s *positionServiceTestSuite) TestGetMultiAssetMode() {
 	s.r().NoError(err)
 	s.r().Equal(res.MultiAssetsMargin, true)
 }
+
+func (s *positionServiceTestSuite) TestToggleMultiAssetMode() {
+	s.mockDoOnce([]byte(`{"multiAssetsMargin": false}`), nil)
+	s.mockDoOnce([]byte(`{"code": 200, "msg": "success"}`), nil)
+	s.mockDoOnce([]byte(`{"multiAssetsMargin": true}`), nil)
+	var sent []string
+	s.assertReq(func(r *request) {
+		sent = append(sent, r.form.Get("multiAssetsMargin"))
+	})
+
+	mode, err := s.client.NewGetMultiAssetModeService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&MultiAssetMode{MultiAssetsMargin: false}, mode)
+
+	s.r().NoError(s.client.NewChangeMultiAssetModeService().MultiAssetsMargin(!mode.MultiAssetsMargin).Do(newContext()))
+
+	mode, err = s.client.NewGetMultiAssetModeService().Do(newContext())
+	s.r().NoError(err)
+	s.r().True(mode.MultiAssetsMargin)
+	s.r().Equal([]string{"", "true", ""}, sent)
+}
+
+func (s *positionServiceTestSuite) TestChangeMultiAssetModeRequired() {
+	err := s.client.NewChangeMultiAssetModeService().Do(newContext())
+	s.r().EqualError(err, "multiAssetsMargin is required, see MultiAssetsMargin")
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
@@ -0,0 +1,90 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coin-quant/go-aster/v2/common"
+	"github.com/shopspring/decimal"
+)
+
+// QuantityForNotional convert a quote asset notional into a base quantity for a MARKET order.
+// The latest price is divided into notional and the result is floored to the symbol's lot size.
+// An error is returned when the quantity falls below minQty or its notional below minNotional.
+func (c *Client) QuantityForNotional(ctx context.Context, symbol string, notional decimal.Decimal) (decimal.Decimal, error) {
+	if !notional.IsPositive() {
+		return decimal.Zero, errors.New("notional must be positive")
+	}
+	prices, err := c.NewListPricesService().Symbol(symbol).Do(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if len(prices) == 0 {
+		return decimal.Zero, fmt.Errorf("no price for symbol %s", symbol)
+	}
+	price, err := parseDecimal("price", prices[0].Price)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if !price.IsPositive() {
+		return decimal.Zero, fmt.Errorf("invalid price %s for symbol %s", price, symbol)
+	}
+	info, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	s := info.Symbol(symbol)
+	if s == nil {
+		return decimal.Zero, fmt.Errorf("symbol %s not found in exchange info", symbol)
+	}
+	return quantityForNotional(s, price, notional)
+}
+
+func quantityForNotional(s *Symbol, price, notional decimal.Decimal) (decimal.Decimal, error) {
+	minQty, stepSize, err := lotSize(s, OrderTypeMarket)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	raw := notional.Div(price)
+	if raw.LessThan(minQty) {
+		return decimal.Zero, fmt.Errorf("quantity %s for notional %s is below minQty %s", raw.Truncate(int32(s.QuantityPrecision)), notional, minQty)
+	}
+	quantity, err := decimal.NewFromString(common.AmountToLotSize(raw.String(), minQty.String(), stepSize.String(), s.QuantityPrecision))
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if f := s.MinNotionalFilter(); f != nil && f.Notional != "" {
+		minNotional, err := parseDecimal("minNotional", f.Notional)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		if quantity.Mul(price).LessThan(minNotional) {
+			return decimal.Zero, fmt.Errorf("notional %s of quantity %s is below minNotional %s", quantity.Mul(price), quantity, f.Notional)
+		}
+	}
+	return quantity, nil
+}
+
+// lotSize return the minQty and stepSize of the lot size filter of s applying to orders of orderType:
+// MARKET_LOT_SIZE for MARKET orders when the symbol has one, LOT_SIZE otherwise. A non-positive stepSize is rejected.
+func lotSize(s *Symbol, orderType OrderType) (minQty, stepSize decimal.Decimal, err error) {
+	var minQtyStr, stepSizeStr string
+	if f := s.MarketLotSizeFilter(); f != nil && orderType == OrderTypeMarket {
+		minQtyStr, stepSizeStr = f.MinQuantity, f.StepSize
+	} else if f := s.LotSizeFilter(); f != nil {
+		minQtyStr, stepSizeStr = f.MinQuantity, f.StepSize
+	} else {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("no lot size filter for symbol %s", s.Symbol)
+	}
+	if minQty, err = parseDecimal("minQty", minQtyStr); err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	if stepSize, err = parseDecimal("stepSize", stepSizeStr); err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	if !stepSize.IsPositive() {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("invalid stepSize %s for symbol %s", stepSizeStr, s.Symbol)
+	}
+	return minQty, stepSize, nil
+}
@@ -0,0 +1,73 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type quantityTestSuite struct {
+	baseTestSuite
+}
+
+func TestQuantity(t *testing.T) {
+	suite.Run(t, new(quantityTestSuite))
+}
+
+var quantityExchangeInfoData = []byte(`{
+	"symbols": [
+		{
+			"symbol": "BTCUSDT",
+			"quantityPrecision": 3,
+			"filters": [
+				{"filterType": "LOT_SIZE", "maxQty": "1000", "minQty": "0.001", "stepSize": "0.001"},
+				{"filterType": "MARKET_LOT_SIZE", "maxQty": "120", "minQty": "0.001", "stepSize": "0.001"},
+				{"filterType": "MIN_NOTIONAL", "notional": "5"}
+			]
+		}
+	]
+}`)
+
+func (s *quantityTestSuite) TestQuantityForNotional() {
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "price": "60123.40", "time": 1712730000000}`), nil)
+	s.mockDoOnce(quantityExchangeInfoData, nil)
+
+	quantity, err := s.client.QuantityForNotional(newContext(), "BTCUSDT", decimal.NewFromInt(1000))
+	s.r().NoError(err)
+	s.r().Equal("0.016", quantity.String())
+}
+
+func (s *quantityTestSuite) TestQuantityForNotionalBelowMinQty() {
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "price": "60000"}`), nil)
+	s.mockDoOnce(quantityExchangeInfoData, nil)
+
+	_, err := s.client.QuantityForNotional(newContext(), "BTCUSDT", decimal.NewFromInt(30))
+	s.r().EqualError(err, "quantity 0 for notional 30 is below minQty 0.001")
+}
+
+func (s *quantityTestSuite) TestQuantityForNotionalBelowMinNotional() {
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "price": "3000"}`), nil)
+	s.mockDoOnce(quantityExchangeInfoData, nil)
+
+	_, err := s.client.QuantityForNotional(newContext(), "BTCUSDT", decimal.RequireFromString("5.5"))
+	s.r().EqualError(err, "notional 3 of quantity 0.001 is below minNotional 5")
+}
+
+func (s *quantityTestSuite) TestQuantityForNotionalZeroStepSize() {
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "price": "60000"}`), nil)
+	s.mockDoOnce([]byte(`{
+		"symbols": [
+			{
+				"symbol": "BTCUSDT",
+				"quantityPrecision": 3,
+				"filters": [
+					{"filterType": "MARKET_LOT_SIZE", "maxQty": "120", "minQty": "0.001", "stepSize": "0"}
+				]
+			}
+		]
+	}`), nil)
+
+	_, err := s.client.QuantityForNotional(newContext(), "BTCUSDT", decimal.NewFromInt(1000))
+	s.r().EqualError(err, "invalid stepSize 0 for symbol BTCUSDT")
+}
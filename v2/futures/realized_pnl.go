@@ -0,0 +1,53 @@
+package futures
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RealizedPnL return the sum of the REALIZED_PNL incomes of symbol between start and end, all the symbols
+// when symbol is empty. Incomes are summed whatever their asset, restrict symbol to a single margin asset
+// in multi-assets mode.
+func (c *Client) RealizedPnL(ctx context.Context, symbol string, start, end time.Time) (decimal.Decimal, error) {
+	return c.sumIncomes(ctx, symbol, start, end, IncomeTypeRealizedPnl)
+}
+
+// NetPnL is similar to RealizedPnL, but the commissions and the funding fees are netted
+func (c *Client) NetPnL(ctx context.Context, symbol string, start, end time.Time) (decimal.Decimal, error) {
+	return c.sumIncomes(ctx, symbol, start, end, IncomeTypeRealizedPnl, IncomeTypeCommission, IncomeTypeFundingFee)
+}
+
+// sumIncomes sum the incomes of the given types, the API is filtered on the type when there is a single one
+func (c *Client) sumIncomes(ctx context.Context, symbol string, start, end time.Time, incomeTypes ...IncomeType) (decimal.Decimal, error) {
+	it := c.NewIncomeIterator().Symbol(symbol).StartTime(start.UnixMilli()).EndTime(end.UnixMilli())
+	if len(incomeTypes) == 1 {
+		it.Type(incomeTypes[0])
+	}
+	sum := decimal.Zero
+	for it.Next(ctx) {
+		income := it.Income()
+		if !containsIncomeType(incomeTypes, income.IncomeType) {
+			continue
+		}
+		amount, err := income.IncomeDecimal()
+		if err != nil {
+			return decimal.Zero, err
+		}
+		sum = sum.Add(amount)
+	}
+	if err := it.Err(); err != nil {
+		return decimal.Zero, err
+	}
+	return sum, nil
+}
+
+func containsIncomeType(incomeTypes []IncomeType, incomeType IncomeType) bool {
+	for _, t := range incomeTypes {
+		if t == incomeType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package futures
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type realizedPnLTestSuite struct {
+	baseTestSuite
+}
+
+func TestRealizedPnL(t *testing.T) {
+	suite.Run(t, new(realizedPnLTestSuite))
+}
+
+var realizedPnLIncomes = []byte(`[
+	{"symbol": "BTCUSDT", "incomeType": "REALIZED_PNL", "income": "12.50", "asset": "USDT", "time": 1700000000000, "tranId": 1, "tradeId": "11"},
+	{"symbol": "BTCUSDT", "incomeType": "COMMISSION", "income": "-0.75", "asset": "USDT", "time": 1700000000000, "tranId": 1, "tradeId": "11"},
+	{"symbol": "BTCUSDT", "incomeType": "FUNDING_FEE", "income": "-1.20", "asset": "USDT", "time": 1700000100000, "tranId": 2},
+	{"symbol": "BTCUSDT", "incomeType": "REALIZED_PNL", "income": "-4.25", "asset": "USDT", "time": 1700000200000, "tranId": 3, "tradeId": "12"},
+	{"symbol": "BTCUSDT", "incomeType": "COMMISSION", "income": "-0.30", "asset": "USDT", "time": 1700000200000, "tranId": 3, "tradeId": "12"},
+	{"symbol": "BTCUSDT", "incomeType": "TRANSFER", "income": "1000", "asset": "USDT", "time": 1700000300000, "tranId": 4},
+	{"symbol": "BTCUSDT", "incomeType": "REALIZED_PNL", "income": "3", "asset": "USDT", "time": 1700000400000, "tranId": 5, "tradeId": "13"}
+]`)
+
+func (s *realizedPnLTestSuite) TestRealizedPnL() {
+	s.mockDoOnce(realizedPnLIncomes, nil)
+
+	start, end := time.UnixMilli(1700000000000), time.UnixMilli(1700000500000)
+	pnl, err := s.client.RealizedPnL(newContext(), "BTCUSDT", start, end)
+	r := s.r()
+	r.NoError(err)
+	r.Equal("11.25", pnl.String())
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *realizedPnLTestSuite) TestNetPnL() {
+	s.mockDoOnce(realizedPnLIncomes, nil)
+
+	start, end := time.UnixMilli(1700000000000), time.UnixMilli(1700000500000)
+	pnl, err := s.client.NetPnL(newContext(), "BTCUSDT", start, end)
+	r := s.r()
+	r.NoError(err)
+	// 12.50 - 0.75 - 1.20 - 4.25 - 0.30 + 3
+	r.Equal("9", pnl.String())
+}
+
+func (s *realizedPnLTestSuite) TestRealizedPnLError() {
+	s.mockDoOnce(nil, errors.New("dummy err"))
+
+	_, err := s.client.RealizedPnL(newContext(), "BTCUSDT", time.UnixMilli(0), time.UnixMilli(1000))
+	s.r().EqualError(err, "dummy err")
+}
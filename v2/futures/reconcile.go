@@ -0,0 +1,48 @@
+package futures
+
+import (
+	"context"
+	"sort"
+)
+
+// LocalOrder is an order a caller believes to be open, see ReconcileOpenOrders
+type LocalOrder struct {
+	Symbol   string
+	OrderID  int64
+	Side     SideType
+	Price    string
+	Quantity string
+}
+
+// Reconciliation is the result of ReconcileOpenOrders
+type Reconciliation struct {
+	Matched    []*Order // open on the exchange and known locally
+	LocalOnly  []string // clientOrderIds known locally but not open anymore, presumably filled or canceled
+	RemoteOnly []*Order // open on the exchange but unknown locally
+}
+
+// ReconcileOpenOrders compare the local open orders, keyed by clientOrderId, with the open orders of the exchange.
+// LocalOnly is sorted, Matched and RemoteOnly keep the order of the exchange response.
+func (c *Client) ReconcileOpenOrders(ctx context.Context, local map[string]LocalOrder) (*Reconciliation, error) {
+	orders, err := c.NewListOpenOrdersService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &Reconciliation{}
+	remote := make(map[string]struct{}, len(orders))
+	for _, order := range orders {
+		remote[order.ClientOrderID] = struct{}{}
+		if _, ok := local[order.ClientOrderID]; ok {
+			res.Matched = append(res.Matched, order)
+		} else {
+			res.RemoteOnly = append(res.RemoteOnly, order)
+		}
+	}
+	for id := range local {
+		if _, ok := remote[id]; !ok {
+			res.LocalOnly = append(res.LocalOnly, id)
+		}
+	}
+	sort.Strings(res.LocalOnly)
+	return res, nil
+}
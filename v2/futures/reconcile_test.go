@@ -0,0 +1,48 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type reconcileTestSuite struct {
+	baseTestSuite
+}
+
+func TestReconcile(t *testing.T) {
+	suite.Run(t, new(reconcileTestSuite))
+}
+
+func (s *reconcileTestSuite) TestReconcileOpenOrders() {
+	s.mockDo([]byte(`[
+		{"symbol": "BTCUSDT", "orderId": 1, "clientOrderId": "matched", "status": "NEW", "type": "LIMIT"},
+		{"symbol": "ETHUSDT", "orderId": 2, "clientOrderId": "orphan", "status": "PARTIALLY_FILLED", "type": "LIMIT"},
+		{"symbol": "BTCUSDT", "orderId": 3, "clientOrderId": "matched2", "status": "NEW", "type": "LIMIT"}
+	]`), nil)
+	defer s.assertDo()
+
+	res, err := s.client.ReconcileOpenOrders(newContext(), map[string]LocalOrder{
+		"matched":  {Symbol: "BTCUSDT", OrderID: 1},
+		"matched2": {Symbol: "BTCUSDT", OrderID: 3},
+		"missing2": {Symbol: "BTCUSDT", OrderID: 5},
+		"missing":  {Symbol: "ETHUSDT", OrderID: 4},
+	})
+	s.r().NoError(err)
+	s.r().Len(res.Matched, 2)
+	s.r().Equal("matched", res.Matched[0].ClientOrderID)
+	s.r().Equal("matched2", res.Matched[1].ClientOrderID)
+	s.r().Len(res.RemoteOnly, 1)
+	s.r().Equal(int64(2), res.RemoteOnly[0].OrderID)
+	s.r().Equal([]string{"missing", "missing2"}, res.LocalOnly)
+}
+
+func (s *reconcileTestSuite) TestReconcileOpenOrdersEmpty() {
+	s.mockDo([]byte(`[]`), nil)
+
+	res, err := s.client.ReconcileOpenOrders(newContext(), nil)
+	s.r().NoError(err)
+	s.r().Empty(res.Matched)
+	s.r().Empty(res.RemoteOnly)
+	s.r().Empty(res.LocalOnly)
+}
@@ -1,10 +1,11 @@
 package futures
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
 type secType int
@@ -26,8 +27,18 @@ type request struct {
 	recvWindow int64
 	secType    secType
 	header     http.Header
-	body       io.Reader
-	fullURL    string
+}
+
+// mergeInto copy the query, the form and recvWindow of r into params of the signed call path
+func (r *request) mergeInto(params map[string]interface{}) {
+	for _, values := range []url.Values{r.query, r.form} {
+		for k := range values {
+			params[k] = values.Get(k)
+		}
+	}
+	if r.recvWindow > 0 {
+		params[recvWindowKey] = strconv.FormatInt(r.recvWindow, 10)
+	}
 }
 
 // setParam set param with key/value to query string
@@ -74,6 +85,23 @@ func (r *request) validate() (err error) {
 	return nil
 }
 
+// RequestIDHeader is the header carrying the request id set with ContextWithRequestID
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// ContextWithRequestID return a copy of ctx carrying requestID, requests sent with it have the X-Request-ID header
+// set and the id is included in the debug logs, to correlate them with a distributed trace
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext return the request id set with ContextWithRequestID
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
 // RequestOption define option type for request
 type RequestOption func(*request)
 
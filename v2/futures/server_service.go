@@ -35,12 +35,13 @@ func (s *ServerTimeService) Do(ctx context.Context, opts ...RequestOption) (serv
 	if err != nil {
 		return 0, err
 	}
-	j, err := newJSON(data)
-	if err != nil {
+	res := new(struct {
+		ServerTime int64 `json:"serverTime"`
+	})
+	if err = s.c.unmarshal(data, res); err != nil {
 		return 0, err
 	}
-	serverTime = j.Get("serverTime").MustInt64()
-	return serverTime, nil
+	return res.ServerTime, nil
 }
 
 // SetServerTimeService set server time
@@ -91,7 +91,7 @@ func (s *serverServiceTestSuite) TestInvalidResponseBody() {
 }
 
 func (s *serverServiceTestSuite) TestSetServerTime() {
-	data := []byte(`1399827319559`)
+	data := []byte(`{"serverTime": 1399827319559}`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
 
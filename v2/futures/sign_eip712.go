@@ -0,0 +1,83 @@
+package futures
+
+import (
+	"math/big"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SigningScheme define how signed requests are signed
+type SigningScheme string
+
+// Signing schemes
+const (
+	// SigningSchemePersonalSign sign the ABI encoded (params, user, signer, nonce) with EIP-191 personal_sign
+	SigningSchemePersonalSign SigningScheme = "PERSONAL_SIGN"
+	// SigningSchemeEIP712 sign (params, user, signer, nonce) as EIP-712 typed data, see DefaultEIP712Domain
+	SigningSchemeEIP712 SigningScheme = "EIP712"
+)
+
+// EIP712Domain define the EIP-712 domain separating the typed data of SigningSchemeEIP712
+type EIP712Domain struct {
+	Name              string
+	Version           string
+	ChainID           *big.Int
+	VerifyingContract string
+}
+
+// DefaultEIP712Domain is the EIP-712 domain used by clients with SigningSchemeEIP712.
+// It is a placeholder which is not verified against the exchange: Aster doesn't document an EIP-712 domain
+// for API requests, so set the one it expects with Client.EIP712Domain before relying on this scheme.
+var DefaultEIP712Domain = EIP712Domain{
+	Name:              "AsterSignTransaction",
+	Version:           "1",
+	ChainID:           big.NewInt(1666),
+	VerifyingContract: "0x0000000000000000000000000000000000000000",
+}
+
+var (
+	eip712DomainTypeHash  = crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	eip712MessageTypeHash = crypto.Keccak256([]byte("Message(string params,address user,address signer,uint256 nonce)"))
+)
+
+// WithSigningScheme set the signing scheme of the client
+func WithSigningScheme(scheme SigningScheme) ClientOption {
+	return func(c *Client) {
+		c.SigningScheme = scheme
+	}
+}
+
+// separator return the EIP-712 domain separator
+func (d EIP712Domain) separator() []byte {
+	chainID := new(big.Int)
+	if d.ChainID != nil {
+		chainID = d.ChainID
+	}
+	return crypto.Keccak256(eip712DomainTypeHash,
+		crypto.Keccak256([]byte(d.Name)),
+		crypto.Keccak256([]byte(d.Version)),
+		eip712Word(chainID.Bytes()),
+		eip712Word(eth.HexToAddress(d.VerifyingContract).Bytes()),
+	)
+}
+
+// eip712Word left pad b to a 32 bytes EIP-712 encoded word
+func eip712Word(b []byte) []byte {
+	return eth.LeftPadBytes(b, 32)
+}
+
+// eip712Hash return the EIP-712 hash of the normalized params
+func (c *Client) eip712Hash(trimmed string, nonce uint64) (eth.Hash, error) {
+	domain := DefaultEIP712Domain
+	if c.EIP712Domain != nil {
+		domain = *c.EIP712Domain
+	}
+	structHash := crypto.Keccak256(eip712MessageTypeHash,
+		crypto.Keccak256([]byte(trimmed)),
+		eip712Word(eth.HexToAddress(c.User).Bytes()),
+		eip712Word(eth.HexToAddress(c.Signer).Bytes()),
+		eip712Word(new(big.Int).SetUint64(nonce).Bytes()),
+	)
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domain.separator(), structHash), nil
+}
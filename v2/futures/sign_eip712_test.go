@@ -0,0 +1,79 @@
+package futures
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// eip712Vector compute the typed data hash step by step as described by EIP-712
+func eip712Vector(params, user, signer string, nonce uint64) []byte {
+	word := func(n int64) []byte {
+		return eth.LeftPadBytes(big.NewInt(n).Bytes(), 32)
+	}
+	domainType := crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	domainSeparator := crypto.Keccak256(domainType,
+		crypto.Keccak256([]byte("AsterSignTransaction")),
+		crypto.Keccak256([]byte("1")),
+		word(1666),
+		eth.LeftPadBytes(eth.Address{}.Bytes(), 32),
+	)
+	messageType := crypto.Keccak256([]byte("Message(string params,address user,address signer,uint256 nonce)"))
+	structHash := crypto.Keccak256(messageType,
+		crypto.Keccak256([]byte(params)),
+		eth.LeftPadBytes(eth.HexToAddress(user).Bytes(), 32),
+		eth.LeftPadBytes(eth.HexToAddress(signer).Bytes(), 32),
+		eth.LeftPadBytes(new(big.Int).SetUint64(nonce).Bytes(), 32),
+	)
+	return crypto.Keccak256([]byte("\x19\x01"), domainSeparator, structHash)
+}
+
+func TestEIP712SignHash(t *testing.T) {
+	user := "0x63DD5aCC6b1aa0f563956C0e534DD30B6dcF7C4e"
+	signer := "0x21cF8Ae13Bb72632562c6Fff438652Ba1a151bb0"
+	c := NewClient(user, signer, testPrivateKey, WithSigningScheme(SigningSchemeEIP712))
+	params := newSignTestParams()
+	trimmed, err := normalizeAndStringify(params)
+	require.NoError(t, err)
+
+	hash, err := c.signHash(params, 1759212310710000)
+	require.NoError(t, err)
+	require.Equal(t, eip712Vector(trimmed, user, signer, 1759212310710000), hash.Bytes())
+	require.Equal(t, "0xd930a435db73727ccbb52fd4683b57dd4acfef5986e28fa6561b84d2518f6392", hash.Hex())
+
+	personal, err := NewClient(user, signer, testPrivateKey).signHash(params, 1759212310710000)
+	require.NoError(t, err)
+	require.NotEqual(t, personal, hash)
+}
+
+func TestEIP712SignRecoverSigner(t *testing.T) {
+	key, err := crypto.HexToECDSA(testPrivateKey)
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	c := NewClient("0x63DD5aCC6b1aa0f563956C0e534DD30B6dcF7C4e", signer, testPrivateKey, WithSigningScheme(SigningSchemeEIP712))
+
+	params := newSignTestParams()
+	hash, err := c.signHash(params, 1)
+	require.NoError(t, err)
+	require.NoError(t, c.signParams(params, 1))
+
+	sig, err := hex.DecodeString(strings.TrimPrefix(params["signature"].(string), "0x"))
+	require.NoError(t, err)
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	require.NoError(t, err)
+	require.Equal(t, signer, crypto.PubkeyToAddress(*pub).Hex())
+}
+
+func TestSignHashUnknownScheme(t *testing.T) {
+	c := NewClient("0x63DD5aCC6b1aa0f563956C0e534DD30B6dcF7C4e", "0x21cF8Ae13Bb72632562c6Fff438652Ba1a151bb0", testPrivateKey,
+		WithSigningScheme("EIP191"))
+	_, err := c.signHash(newSignTestParams(), 1)
+	require.EqualError(t, err, `unknown signing scheme "EIP191"`)
+	require.EqualError(t, c.signParams(newSignTestParams(), 1), `unknown signing scheme "EIP191"`)
+}
@@ -0,0 +1,88 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+type groupStream struct {
+	name  string
+	doneC chan struct{}
+	stopC chan struct{}
+}
+
+// StreamGroup stop a set of WS streams and the listenKey manager together
+type StreamGroup struct {
+	mu               sync.Mutex
+	streams          []groupStream
+	listenKeyManager *ListenKeyManager
+	shutdown         bool
+}
+
+// NewStreamGroup init an empty stream group
+func NewStreamGroup() *StreamGroup {
+	return &StreamGroup{}
+}
+
+// Add register the doneC and stopC returned by a WsXxxServe function, the stream is stopped
+// right away when the group is already shut down
+func (g *StreamGroup) Add(name string, doneC, stopC chan struct{}) *StreamGroup {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.shutdown {
+		stopStream(stopC)
+		return g
+	}
+	g.streams = append(g.streams, groupStream{name: name, doneC: doneC, stopC: stopC})
+	return g
+}
+
+// SetListenKeyManager register the listenKey manager closed after the streams on Shutdown
+func (g *StreamGroup) SetListenKeyManager(m *ListenKeyManager) *StreamGroup {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.listenKeyManager = m
+	return g
+}
+
+// Shutdown signal all the streams to stop, wait for them until ctx is done and close the user stream.
+// Streams that didn't stop in time and a failed user stream close are reported in a joined error.
+func (g *StreamGroup) Shutdown(ctx context.Context) error {
+	g.mu.Lock()
+	if g.shutdown {
+		g.mu.Unlock()
+		return nil
+	}
+	g.shutdown = true
+	streams, manager := g.streams, g.listenKeyManager
+	g.mu.Unlock()
+
+	for _, s := range streams {
+		stopStream(s.stopC)
+	}
+	var errs []error
+	for _, s := range streams {
+		select {
+		case <-s.doneC:
+		case <-ctx.Done():
+			errs = append(errs, fmt.Errorf("stream %s did not stop: %w", s.name, ctx.Err()))
+		}
+	}
+	if manager != nil {
+		if err := manager.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("close user stream: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// stopStream close stopC unless the caller already stopped the stream
+func stopStream(stopC chan struct{}) {
+	select {
+	case <-stopC:
+	default:
+		close(stopC)
+	}
+}
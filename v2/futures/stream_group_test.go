@@ -0,0 +1,100 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type streamGroupTestSuite struct {
+	baseTestSuite
+}
+
+func TestStreamGroup(t *testing.T) {
+	suite.Run(t, new(streamGroupTestSuite))
+}
+
+// fakeStream behave like a stream returned by wsServe, stopping when stopC is closed
+func fakeStream(delay time.Duration) (doneC, stopC chan struct{}, stopped *bool) {
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	stopped = new(bool)
+	go func() {
+		<-stopC
+		time.Sleep(delay)
+		*stopped = true
+		close(doneC)
+	}()
+	return doneC, stopC, stopped
+}
+
+func (s *streamGroupTestSuite) TestShutdown() {
+	s.mockDoOnce([]byte(`{"listenKey": "pqia91ma19a5s61cv6a81va65sdf19v8a65a1"}`), nil)
+	s.mockDoOnce([]byte(`{}`), nil)
+	manager := s.client.NewListenKeyManager(time.Hour, nil)
+	_, err := manager.Start(newContext())
+	s.r().NoError(err)
+
+	group := NewStreamGroup().SetListenKeyManager(manager)
+	var stopped []*bool
+	for _, name := range []string{"aggTrade", "depth", "userData"} {
+		doneC, stopC, ok := fakeStream(time.Millisecond)
+		group.Add(name, doneC, stopC)
+		stopped = append(stopped, ok)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	s.r().NoError(group.Shutdown(ctx))
+	for _, ok := range stopped {
+		s.r().True(*ok)
+	}
+	s.r().Empty(manager.ListenKey())
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+
+	s.r().NoError(group.Shutdown(ctx))
+}
+
+func (s *streamGroupTestSuite) TestShutdownTimeout() {
+	group := NewStreamGroup()
+	doneC, stopC, _ := fakeStream(0)
+	group.Add("fast", doneC, stopC)
+	group.Add("stuck", make(chan struct{}), make(chan struct{}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := group.Shutdown(ctx)
+	s.r().EqualError(err, "stream stuck did not stop: context deadline exceeded")
+	s.r().True(errors.Is(err, context.DeadlineExceeded))
+}
+
+func (s *streamGroupTestSuite) TestShutdownStoppedStream() {
+	group := NewStreamGroup()
+	doneC, stopC, stopped := fakeStream(0)
+	group.Add("stopped", doneC, stopC)
+	close(stopC)
+	<-doneC
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	s.r().NoError(group.Shutdown(ctx))
+	s.r().True(*stopped)
+}
+
+func (s *streamGroupTestSuite) TestAddAfterShutdown() {
+	group := NewStreamGroup()
+	s.r().NoError(group.Shutdown(newContext()))
+
+	doneC, stopC, stopped := fakeStream(0)
+	group.Add("late", doneC, stopC)
+	select {
+	case <-doneC:
+	case <-time.After(time.Second):
+		s.r().FailNow("stream added after Shutdown was not stopped")
+	}
+	s.r().True(*stopped)
+	s.r().Empty(group.streams)
+}
@@ -2,7 +2,6 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 )
 
 // SymbolConfigService get futures symbol configuration
@@ -20,6 +19,19 @@ type SymbolConfig struct {
 	MaxNotionalValue string `json:"maxNotionalValue"`
 }
 
+// SymbolConfigs is the response of SymbolConfigService
+type SymbolConfigs []*SymbolConfig
+
+// BySymbol return the config of symbol, nil if it is not in the list
+func (configs SymbolConfigs) BySymbol(symbol string) *SymbolConfig {
+	for _, config := range configs {
+		if config.Symbol == symbol {
+			return config
+		}
+	}
+	return nil
+}
+
 // Symbol set symbol
 func (s *SymbolConfigService) Symbol(symbol string) *SymbolConfigService {
 	s.symbol = &symbol
@@ -27,7 +39,7 @@ func (s *SymbolConfigService) Symbol(symbol string) *SymbolConfigService {
 }
 
 // Do send request
-func (s *SymbolConfigService) Do(ctx context.Context, opts ...RequestOption) ([]*SymbolConfig, error) {
+func (s *SymbolConfigService) Do(ctx context.Context, opts ...RequestOption) (SymbolConfigs, error) {
 	r := &request{
 		method:   "GET",
 		endpoint: "/fapi/v1/symbolConfig",
@@ -40,8 +52,8 @@ func (s *SymbolConfigService) Do(ctx context.Context, opts ...RequestOption) ([]
 	if err != nil {
 		return nil, err
 	}
-	var res []*SymbolConfig
-	err = json.Unmarshal(data, &res)
+	var res SymbolConfigs
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
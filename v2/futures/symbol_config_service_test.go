@@ -44,3 +44,24 @@ func (s *SymbolConfigServiceTestSuite) TestGetSymbolConfig() {
 	s.r().Equal(21, configs[0].Leverage)
 	s.r().Equal("1000000", configs[0].MaxNotionalValue)
 }
+
+func (s *SymbolConfigServiceTestSuite) TestSymbolConfigsBySymbol() {
+	data := []byte(`[
+		{"symbol": "BTCUSDT", "marginType": "CROSSED", "isAutoAddMargin": false, "leverage": 20, "maxNotionalValue": "1000000"},
+		{"symbol": "ETHUSDT", "marginType": "ISOLATED", "isAutoAddMargin": true, "leverage": 10, "maxNotionalValue": "500000"}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	configs, err := s.client.NewGetSymbolConfigService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SymbolConfig{
+		Symbol:           "ETHUSDT",
+		MarginType:       "ISOLATED",
+		IsAutoAddMargin:  true,
+		Leverage:         10,
+		MaxNotionalValue: "500000",
+	}, configs.BySymbol("ETHUSDT"))
+	s.r().Equal(20, configs.BySymbol("BTCUSDT").Leverage)
+	s.r().Nil(configs.BySymbol("SOLUSDT"))
+}
@@ -2,7 +2,6 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 
 	"github.com/coin-quant/go-aster/v2/common"
@@ -35,7 +34,7 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 		return []*BookTicker{}, err
 	}
 	res = make([]*BookTicker, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*BookTicker{}, err
 	}
@@ -76,13 +75,13 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 		"method": http.MethodGet,
 		"params": param,
 	}
-	data, err := s.c.call(m, false)
+	data, err := s.c.call(ctx, m, false, opts...)
 	if err != nil {
 		return []*SymbolPrice{}, err
 	}
 	data = common.ToJSONList(data)
 	res = make([]*SymbolPrice, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*SymbolPrice{}, err
 	}
@@ -118,13 +117,13 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 		"method": http.MethodGet,
 		"params": param,
 	}
-	data, err := s.c.call(m, false)
+	data, err := s.c.call(ctx, m, false, opts...)
 	if err != nil {
 		return res, err
 	}
 	data = common.ToJSONList(data)
 	res = make([]*PriceChangeStats, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -2,8 +2,9 @@ package futures
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
+
+	"github.com/shopspring/decimal"
 )
 
 // HistoricalTradesService trades
@@ -52,7 +53,7 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 		return
 	}
 	res = make([]*Trade, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return
 	}
@@ -149,7 +150,7 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 		return []*AggTrade{}, err
 	}
 	res = make([]*AggTrade, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*AggTrade{}, err
 	}
@@ -201,7 +202,7 @@ func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (re
 		return []*Trade{}, err
 	}
 	res = make([]*Trade, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*Trade{}, err
 	}
@@ -273,7 +274,7 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 		r.setParam("endTime", *s.endTime)
 	}
 	if s.fromID != nil {
-		r.setParam("fromID", *s.fromID)
+		r.setParam("fromId", *s.fromID)
 	}
 	if s.limit != nil {
 		r.setParam("limit", *s.limit)
@@ -283,7 +284,7 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 		return []*AccountTrade{}, err
 	}
 	res = make([]*AccountTrade, 0)
-	err = json.Unmarshal(data, &res)
+	err = s.c.unmarshal(data, &res)
 	if err != nil {
 		return []*AccountTrade{}, err
 	}
@@ -307,3 +308,13 @@ type AccountTrade struct {
 	Symbol          string           `json:"symbol"`
 	Time            int64            `json:"time"`
 }
+
+// RealizedPnlDecimal return realizedPnl as a decimal
+func (t *AccountTrade) RealizedPnlDecimal() (decimal.Decimal, error) {
+	return parseDecimal("realizedPnl", t.RealizedPnl)
+}
+
+// CommissionDecimal return commission as a decimal, the amount is in CommissionAsset
+func (t *AccountTrade) CommissionDecimal() (decimal.Decimal, error) {
+	return parseDecimal("commission", t.Commission)
+}
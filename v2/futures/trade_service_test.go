@@ -92,7 +92,7 @@ func (s *tradeServiceTestSuite) TestHistoricalTrades() {
 	limit := 3
 	fromID := int64(1)
 	s.assertReq(func(r *request) {
-		e := newRequest().setParams(params{
+		e := newSignedRequest().setParams(params{
 			"symbol": symbol,
 			"limit":  limit,
 			"fromId": fromID,
@@ -197,7 +197,7 @@ func (s *tradeServiceTestSuite) TestAccountTradeList() {
 			"symbol":    symbol,
 			"startTime": startTime,
 			"endTime":   endTime,
-			"fromID":    fromID,
+			"fromId":    fromID,
 			"limit":     limit,
 		})
 		s.assertRequestEqual(e, r)
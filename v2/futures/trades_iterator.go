@@ -0,0 +1,128 @@
+package futures
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultTradesIteratorWindow is the widest startTime/endTime range accepted by a single userTrades request
+var DefaultTradesIteratorWindow = 7 * 24 * time.Hour
+
+// TradesIterator page forward through the account trades of a symbol.
+// Until the first trade is found the range is scanned window by window, then pages are fetched by fromId.
+//
+//	it := client.NewTradesIterator("BTCUSDT").StartTime(start)
+//	for it.Next(ctx) {
+//		pnl, _ := it.Trade().RealizedPnlDecimal()
+//	}
+//	err := it.Err()
+type TradesIterator struct {
+	c         *Client
+	symbol    string
+	startTime int64
+	endTime   int64
+	fromID    *int64
+	limit     int
+	window    time.Duration
+
+	started     bool
+	windowStart int64
+	seen        bool
+	lastID      int64
+	pager[*AccountTrade]
+}
+
+// NewTradesIterator init an account trades iterator for symbol
+func (c *Client) NewTradesIterator(symbol string) *TradesIterator {
+	it := &TradesIterator{c: c, symbol: symbol, limit: 1000, window: DefaultTradesIteratorWindow}
+	it.nextPage = it.fetch
+	return it
+}
+
+// StartTime set startTime in ms, default to one window before endTime
+func (it *TradesIterator) StartTime(startTime int64) *TradesIterator {
+	it.startTime = startTime
+	return it
+}
+
+// EndTime set endTime in ms, default to now
+func (it *TradesIterator) EndTime(endTime int64) *TradesIterator {
+	it.endTime = endTime
+	return it
+}
+
+// FromID set the first trade id, the time windows are skipped
+func (it *TradesIterator) FromID(fromID int64) *TradesIterator {
+	it.fromID = &fromID
+	return it
+}
+
+// Limit set page size
+func (it *TradesIterator) Limit(limit int) *TradesIterator {
+	it.limit = limit
+	return it
+}
+
+// Window set the time range of a single request
+func (it *TradesIterator) Window(window time.Duration) *TradesIterator {
+	it.window = window
+	return it
+}
+
+// Trade return the current trade
+func (it *TradesIterator) Trade() *AccountTrade {
+	return it.current
+}
+
+func (it *TradesIterator) init() {
+	it.started = true
+	if it.endTime == 0 {
+		it.endTime = time.Now().UnixMilli()
+	}
+	if it.fromID != nil {
+		it.seen, it.lastID = true, *it.fromID-1
+		return
+	}
+	if it.startTime == 0 {
+		it.startTime = it.endTime - it.window.Milliseconds()
+	}
+	it.windowStart = it.startTime
+}
+
+func (it *TradesIterator) fetch(ctx context.Context) (page []*AccountTrade, last bool, err error) {
+	if !it.started {
+		it.init()
+	}
+	byID := it.seen
+	s := it.c.NewListAccountTradeService().Symbol(it.symbol).Limit(it.limit)
+	var windowEnd int64
+	if byID {
+		s.FromID(it.lastID + 1)
+	} else {
+		windowEnd = min(it.windowStart+it.window.Milliseconds()-1, it.endTime)
+		s.StartTime(it.windowStart).EndTime(windowEnd)
+	}
+	trades, err := s.Do(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, t := range trades {
+		// pages may overlap on their boundary
+		if it.seen && t.ID <= it.lastID {
+			continue
+		}
+		if t.Time > it.endTime {
+			return page, true, nil
+		}
+		page = append(page, t)
+		it.seen, it.lastID = true, t.ID
+	}
+	switch {
+	case byID:
+		last = len(trades) < it.limit || len(page) == 0
+	case len(trades) == 0:
+		it.windowStart = windowEnd + 1
+		last = it.windowStart > it.endTime
+	}
+	return page, last, nil
+}
@@ -0,0 +1,76 @@
+package futures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type tradesIteratorTestSuite struct {
+	baseTestSuite
+}
+
+func TestTradesIterator(t *testing.T) {
+	suite.Run(t, new(tradesIteratorTestSuite))
+}
+
+const accountTradeRow = `{"id": %[1]d, "symbol": "BTCUSDT", "time": %[1]d, "realizedPnl": "-0.5", "commission": "0.01", "commissionAsset": "USDT"}`
+
+func accountTradeID(t *AccountTrade) int64 {
+	return t.ID
+}
+
+func (s *tradesIteratorTestSuite) TestPages() {
+	// first window is empty, second one finds a full page, then pages by fromId overlap on their boundary
+	s.mockDoOnce(pageData(accountTradeRow), nil)
+	s.mockDoOnce(pageData(accountTradeRow, 1, 2, 3), nil)
+	s.mockDoOnce(pageData(accountTradeRow, 3, 4, 5), nil)
+	s.mockDoOnce(pageData(accountTradeRow, 5, 6), nil)
+
+	it := s.client.NewTradesIterator("BTCUSDT").StartTime(1).EndTime(2000).Window(time.Second).Limit(3)
+	s.r().Equal([]int64{1, 2, 3, 4, 5, 6}, collect(&it.pager, accountTradeID))
+	s.r().NoError(it.Err())
+	s.client.AssertNumberOfCalls(s.T(), "do", 4)
+	s.r().False(it.Next(newContext()))
+	s.r().Nil(it.Trade())
+}
+
+func (s *tradesIteratorTestSuite) TestEndTime() {
+	s.mockDoOnce(pageData(accountTradeRow, 1, 2), nil)
+	s.mockDoOnce(pageData(accountTradeRow, 3, 4), nil)
+
+	it := s.client.NewTradesIterator("BTCUSDT").FromID(1).EndTime(3).Limit(2)
+	s.r().Equal([]int64{1, 2, 3}, collect(&it.pager, accountTradeID))
+	s.r().NoError(it.Err())
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *tradesIteratorTestSuite) TestEmptyRange() {
+	s.mockDoOnce(pageData(accountTradeRow), nil)
+	s.mockDoOnce(pageData(accountTradeRow), nil)
+
+	// default startTime is one window before endTime, so [1999, 2998] and [2999, 2999] are scanned
+	it := s.client.NewTradesIterator("BTCUSDT").EndTime(2999).Window(time.Second)
+	s.r().Empty(collect(&it.pager, accountTradeID))
+	s.r().NoError(it.Err())
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *tradesIteratorTestSuite) TestTypedFields() {
+	s.mockDo(pageData(accountTradeRow, 1), nil)
+
+	it := s.client.NewTradesIterator("BTCUSDT").FromID(1)
+	s.r().True(it.Next(newContext()))
+	pnl, err := it.Trade().RealizedPnlDecimal()
+	s.r().NoError(err)
+	s.r().True(decimal.RequireFromString("-0.5").Equal(pnl))
+	commission, err := it.Trade().CommissionDecimal()
+	s.r().NoError(err)
+	s.r().True(decimal.RequireFromString("0.01").Equal(commission))
+	s.r().Equal("USDT", it.Trade().CommissionAsset)
+
+	_, err = (&AccountTrade{RealizedPnl: "x"}).RealizedPnlDecimal()
+	s.r().Error(err)
+}
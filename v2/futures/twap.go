@@ -0,0 +1,251 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrTWAPStopped is returned by TWAPExecutor.Run when Stop is called before every slice is placed
+var ErrTWAPStopped = errors.New("twap executor stopped")
+
+// TWAPExecutor split a parent quantity into child orders placed at a regular interval.
+// Every slice is floored to the symbol's lot size and the last slice carries the remainder.
+// Fills of the child orders are tracked from the ORDER_TRADE_UPDATE events passed to Handle.
+type TWAPExecutor struct {
+	c            *Client
+	symbol       string
+	side         SideType
+	quantity     decimal.Decimal
+	slices       int
+	interval     time.Duration
+	orderType    OrderType
+	price        string
+	timeInForce  TimeInForceType
+	positionSide *PositionSideType
+	after        func(time.Duration) <-chan time.Time
+
+	mu       sync.Mutex
+	orderIDs []string
+	status   map[string]OrderStatusType
+	filled   map[string]decimal.Decimal
+	stopC    chan struct{}
+	stopOnce sync.Once
+	doneC    chan struct{}
+}
+
+// NewTWAPExecutor init a TWAPExecutor placing quantity as slices MARKET orders, one every interval
+func (c *Client) NewTWAPExecutor(symbol string, side SideType, quantity decimal.Decimal, slices int, interval time.Duration) *TWAPExecutor {
+	return &TWAPExecutor{
+		c:         c,
+		symbol:    symbol,
+		side:      side,
+		quantity:  quantity,
+		slices:    slices,
+		interval:  interval,
+		orderType: OrderTypeMarket,
+		after:     time.After,
+		status:    map[string]OrderStatusType{},
+		filled:    map[string]decimal.Decimal{},
+		stopC:     make(chan struct{}),
+	}
+}
+
+// Limit place the slices as LIMIT orders at price
+func (e *TWAPExecutor) Limit(price string, timeInForce TimeInForceType) *TWAPExecutor {
+	e.orderType = OrderTypeLimit
+	e.price = price
+	e.timeInForce = timeInForce
+	return e
+}
+
+// PositionSide set positionSide of the child orders
+func (e *TWAPExecutor) PositionSide(positionSide PositionSideType) *TWAPExecutor {
+	e.positionSide = &positionSide
+	return e
+}
+
+// Run place the first slice immediately and the next ones every interval.
+// It blocks until every slice is placed, ctx is done or Stop is called.
+func (e *TWAPExecutor) Run(ctx context.Context) error {
+	e.mu.Lock()
+	if e.doneC != nil {
+		e.mu.Unlock()
+		return errors.New("twap executor already run")
+	}
+	e.doneC = make(chan struct{})
+	e.mu.Unlock()
+	defer close(e.doneC)
+
+	quantities, err := e.sliceQuantities(ctx)
+	if err != nil {
+		return err
+	}
+	prefix := "twap" + strconv.FormatInt(e.c.currentTime().UnixMilli(), 10)
+	for i, quantity := range quantities {
+		if i > 0 {
+			select {
+			case <-e.after(e.interval):
+			case <-e.stopC:
+				return ErrTWAPStopped
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		select {
+		case <-e.stopC:
+			return ErrTWAPStopped
+		default:
+		}
+		if err := e.place(ctx, fmt.Sprintf("%s-%d", prefix, i), quantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *TWAPExecutor) sliceQuantities(ctx context.Context) ([]decimal.Decimal, error) {
+	if e.slices <= 0 {
+		return nil, fmt.Errorf("slices must be positive, got %d", e.slices)
+	}
+	if !e.quantity.IsPositive() {
+		return nil, errors.New("quantity must be positive")
+	}
+	info, err := e.c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s := info.Symbol(e.symbol)
+	if s == nil {
+		return nil, fmt.Errorf("symbol %s not found in exchange info", e.symbol)
+	}
+	minQty, step, err := lotSize(s, e.orderType)
+	if err != nil {
+		return nil, err
+	}
+	floor := func(q decimal.Decimal) decimal.Decimal {
+		return q.Div(step).Floor().Mul(step)
+	}
+	slice := floor(e.quantity.Div(decimal.NewFromInt(int64(e.slices))))
+	if slice.LessThan(minQty) {
+		return nil, fmt.Errorf("slice quantity %s is below minQty %s", slice, minQty)
+	}
+	quantities := make([]decimal.Decimal, e.slices)
+	for i := range quantities {
+		quantities[i] = slice
+	}
+	quantities[e.slices-1] = floor(e.quantity.Sub(slice.Mul(decimal.NewFromInt(int64(e.slices - 1)))))
+	return quantities, nil
+}
+
+func (e *TWAPExecutor) place(ctx context.Context, clientOrderID string, quantity decimal.Decimal) error {
+	// register the child before placing it, its fills may be handled before the response
+	e.mu.Lock()
+	e.orderIDs = append(e.orderIDs, clientOrderID)
+	e.status[clientOrderID] = OrderStatusTypeNew
+	e.mu.Unlock()
+
+	s := e.c.NewCreateOrderService().Symbol(e.symbol).Side(e.side).Type(e.orderType).
+		Quantity(quantity.String()).NewClientOrderID(clientOrderID)
+	if e.orderType == OrderTypeLimit {
+		s.Price(e.price).TimeInForce(e.timeInForce)
+	}
+	if e.positionSide != nil {
+		s.PositionSide(*e.positionSide)
+	}
+	res, err := s.Do(ctx)
+	if err != nil {
+		e.mu.Lock()
+		e.status[clientOrderID] = OrderStatusTypeRejected
+		e.mu.Unlock()
+		return err
+	}
+	e.update(clientOrderID, res.Status, res.ExecutedQuantity)
+	return nil
+}
+
+// Handle update the fills of the child orders, pass it the events of the user data stream
+func (e *TWAPExecutor) Handle(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeOrderTradeUpdate {
+		return
+	}
+	update := event.OrderTradeUpdate
+	if update.Symbol != e.symbol {
+		return
+	}
+	e.update(update.ClientOrderID, update.Status, update.AccumulatedFilledQty)
+}
+
+func (e *TWAPExecutor) update(clientOrderID string, status OrderStatusType, filledQty string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	current, ok := e.status[clientOrderID]
+	if !ok {
+		return
+	}
+	if filled, err := decimal.NewFromString(filledQty); err == nil && filled.GreaterThan(e.filled[clientOrderID]) {
+		e.filled[clientOrderID] = filled
+	}
+	// a late response must not reopen an order already closed by the user stream
+	if status != "" && !isFinalOrderStatus(current) {
+		e.status[clientOrderID] = status
+	}
+}
+
+// Filled return the cumulative filled quantity of the child orders
+func (e *TWAPExecutor) Filled() decimal.Decimal {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	filled := decimal.Zero
+	for _, qty := range e.filled {
+		filled = filled.Add(qty)
+	}
+	return filled
+}
+
+// ClientOrderIDs return the client order ids of the child orders placed so far
+func (e *TWAPExecutor) ClientOrderIDs() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.orderIDs...)
+}
+
+// Stop stop placing slices and cancel the child orders that are still open
+func (e *TWAPExecutor) Stop(ctx context.Context) error {
+	e.stopOnce.Do(func() { close(e.stopC) })
+	e.mu.Lock()
+	doneC := e.doneC
+	e.mu.Unlock()
+	if doneC != nil {
+		select {
+		case <-doneC:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	e.mu.Lock()
+	var open []string
+	for _, id := range e.orderIDs {
+		if !isFinalOrderStatus(e.status[id]) {
+			open = append(open, id)
+		}
+	}
+	e.mu.Unlock()
+
+	var errs []error
+	for _, id := range open {
+		res, err := e.c.NewCancelOrderService().Symbol(e.symbol).OrigClientOrderID(id).Do(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cancel %s: %w", id, err))
+			continue
+		}
+		e.update(id, res.Status, res.ExecutedQuantity)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,142 @@
+package futures
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type twapTestSuite struct {
+	baseTestSuite
+}
+
+func TestTWAPExecutor(t *testing.T) {
+	suite.Run(t, new(twapTestSuite))
+}
+
+// fakeClock record the waits of a TWAPExecutor and release them on demand
+type fakeClock struct {
+	mu     sync.Mutex
+	waits  []time.Duration
+	timers chan chan time.Time
+	auto   bool
+}
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	f.waits = append(f.waits, d)
+	f.mu.Unlock()
+	c := make(chan time.Time, 1)
+	if f.auto {
+		c <- time.Time{}
+		return c
+	}
+	f.timers <- c
+	return c
+}
+
+func (s *twapTestSuite) recordRequests() func() []*request {
+	var mu sync.Mutex
+	var reqs []*request
+	s.assertReq(func(r *request) {
+		mu.Lock()
+		defer mu.Unlock()
+		reqs = append(reqs, r)
+	})
+	return func() []*request {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]*request(nil), reqs...)
+	}
+}
+
+func (s *twapTestSuite) TestRun() {
+	s.mockDoOnce(quantityExchangeInfoData, nil)
+	s.mockDoOnce([]byte(`{"clientOrderId": "a", "status": "FILLED", "executedQty": "0.333"}`), nil)
+	s.mockDoOnce([]byte(`{"clientOrderId": "b", "status": "FILLED", "executedQty": "0.333"}`), nil)
+	s.mockDoOnce([]byte(`{"clientOrderId": "c", "status": "FILLED", "executedQty": "0.334"}`), nil)
+	requests := s.recordRequests()
+
+	clock := &fakeClock{auto: true}
+	e := s.client.NewTWAPExecutor("BTCUSDT", SideTypeBuy, decimal.NewFromInt(1), 3, time.Minute)
+	e.after = clock.After
+	s.r().NoError(e.Run(newContext()))
+
+	s.r().Equal([]time.Duration{time.Minute, time.Minute}, clock.waits)
+	reqs := requests()
+	s.r().Len(reqs, 4)
+	var quantities []string
+	for i, r := range reqs[1:] {
+		s.r().Equal("MARKET", r.form.Get("type"))
+		s.r().Equal(e.ClientOrderIDs()[i], r.form.Get("newClientOrderId"))
+		quantities = append(quantities, r.form.Get("quantity"))
+	}
+	s.r().Equal([]string{"0.333", "0.333", "0.334"}, quantities)
+	s.r().Equal("1", e.Filled().String())
+}
+
+func (s *twapTestSuite) TestStop() {
+	s.mockDoOnce(quantityExchangeInfoData, nil)
+	s.mockDoOnce([]byte(`{"status": "NEW", "executedQty": "0"}`), nil)
+	s.mockDoOnce([]byte(`{"status": "CANCELED", "executedQty": "0.1"}`), nil)
+	requests := s.recordRequests()
+
+	clock := &fakeClock{timers: make(chan chan time.Time)}
+	e := s.client.NewTWAPExecutor("BTCUSDT", SideTypeSell, decimal.NewFromInt(1), 4, time.Second).
+		Limit("60000", TimeInForceTypeGTC)
+	e.after = clock.After
+	errC := make(chan error)
+	go func() { errC <- e.Run(newContext()) }()
+
+	// the first slice is placed, the executor waits for the second one
+	<-clock.timers
+	ids := e.ClientOrderIDs()
+	s.r().Len(ids, 1)
+	e.Handle(&WsUserDataEvent{
+		Event: UserDataEventTypeOrderTradeUpdate,
+		WsUserDataOrderTradeUpdate: WsUserDataOrderTradeUpdate{OrderTradeUpdate: WsOrderTradeUpdate{
+			Symbol: "BTCUSDT", ClientOrderID: ids[0], Status: OrderStatusTypePartiallyFilled, AccumulatedFilledQty: "0.1",
+		}},
+	})
+	s.r().Equal("0.1", e.Filled().String())
+
+	s.r().NoError(e.Stop(newContext()))
+	s.r().ErrorIs(<-errC, ErrTWAPStopped)
+
+	reqs := requests()
+	s.r().Len(reqs, 3)
+	s.r().Equal("LIMIT", reqs[1].form.Get("type"))
+	s.r().Equal("0.25", reqs[1].form.Get("quantity"))
+	s.r().Equal("60000", reqs[1].form.Get("price"))
+	s.r().Equal(ids[0], reqs[2].query.Get("origClientOrderId"))
+	s.r().Equal("0.1", e.Filled().String())
+}
+
+func (s *twapTestSuite) TestSliceBelowMinQty() {
+	s.mockDoOnce(quantityExchangeInfoData, nil)
+
+	e := s.client.NewTWAPExecutor("BTCUSDT", SideTypeBuy, decimal.RequireFromString("0.002"), 3, time.Second)
+	s.r().EqualError(e.Run(newContext()), "slice quantity 0 is below minQty 0.001")
+}
+
+func (s *twapTestSuite) TestZeroStepSize() {
+	s.mockDoOnce([]byte(`{
+		"symbols": [
+			{
+				"symbol": "BTCUSDT",
+				"quantityPrecision": 3,
+				"filters": [
+					{"filterType": "LOT_SIZE", "maxQty": "1000", "minQty": "0.001", "stepSize": "0"}
+				]
+			}
+		]
+	}`), nil)
+
+	e := s.client.NewTWAPExecutor("BTCUSDT", SideTypeBuy, decimal.RequireFromString("0.1"), 3, time.Second).
+		Limit("60000", TimeInForceTypeGTC)
+	s.r().EqualError(e.Run(newContext()), "invalid stepSize 0 for symbol BTCUSDT")
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
@@ -0,0 +1,83 @@
+package futures
+
+// UserDataDispatcher route user data events to the handler registered for their type.
+// Register the handlers before feeding events, e.g. WsUserDataServe(listenKey, dispatcher.Handle, errHandler).
+type UserDataDispatcher struct {
+	handlers       map[UserDataEventType]WsUserDataHandler
+	defaultHandler WsUserDataHandler
+}
+
+// NewUserDataDispatcher init a dispatcher without handler
+func NewUserDataDispatcher() *UserDataDispatcher {
+	return &UserDataDispatcher{handlers: map[UserDataEventType]WsUserDataHandler{}}
+}
+
+// On set the handler of the events of eventType, replacing the previous one
+func (d *UserDataDispatcher) On(eventType UserDataEventType, handler WsUserDataHandler) *UserDataDispatcher {
+	d.handlers[eventType] = handler
+	return d
+}
+
+// OnDefault set the handler of the events without registered handler
+func (d *UserDataDispatcher) OnDefault(handler WsUserDataHandler) *UserDataDispatcher {
+	d.defaultHandler = handler
+	return d
+}
+
+// OnListenKeyExpired set the handler of listenKeyExpired events
+func (d *UserDataDispatcher) OnListenKeyExpired(f func(event *WsUserDataEvent)) *UserDataDispatcher {
+	return d.On(UserDataEventTypeListenKeyExpired, f)
+}
+
+// OnMarginCall set the handler of MARGIN_CALL events
+func (d *UserDataDispatcher) OnMarginCall(f func(marginCall *WsUserDataMarginCall)) *UserDataDispatcher {
+	return d.On(UserDataEventTypeMarginCall, func(event *WsUserDataEvent) {
+		f(&event.WsUserDataMarginCall)
+	})
+}
+
+// OnAccountUpdate set the handler of ACCOUNT_UPDATE events
+func (d *UserDataDispatcher) OnAccountUpdate(f func(update *WsAccountUpdate)) *UserDataDispatcher {
+	return d.On(UserDataEventTypeAccountUpdate, func(event *WsUserDataEvent) {
+		f(&event.AccountUpdate)
+	})
+}
+
+// OnOrderTradeUpdate set the handler of ORDER_TRADE_UPDATE events
+func (d *UserDataDispatcher) OnOrderTradeUpdate(f func(update *WsOrderTradeUpdate)) *UserDataDispatcher {
+	return d.On(UserDataEventTypeOrderTradeUpdate, func(event *WsUserDataEvent) {
+		f(&event.OrderTradeUpdate)
+	})
+}
+
+// OnAccountConfigUpdate set the handler of ACCOUNT_CONFIG_UPDATE events
+func (d *UserDataDispatcher) OnAccountConfigUpdate(f func(update *WsUserDataAccountConfigUpdate)) *UserDataDispatcher {
+	return d.On(UserDataEventTypeAccountConfigUpdate, func(event *WsUserDataEvent) {
+		f(&event.WsUserDataAccountConfigUpdate)
+	})
+}
+
+// OnTradeLite set the handler of TRADE_LITE events
+func (d *UserDataDispatcher) OnTradeLite(f func(trade *WsUserDataTradeLite)) *UserDataDispatcher {
+	return d.On(UserDataEventTypeTradeLite, func(event *WsUserDataEvent) {
+		f(&event.WsUserDataTradeLite)
+	})
+}
+
+// OnConditionalOrderTriggerReject set the handler of CONDITIONAL_ORDER_TRIGGER_REJECT events
+func (d *UserDataDispatcher) OnConditionalOrderTriggerReject(f func(reject *WsConditionalOrderTriggerReject)) *UserDataDispatcher {
+	return d.On(UserDataEventTypeConditionalOrderTriggerReject, func(event *WsUserDataEvent) {
+		f(&event.ConditionalOrderTriggerReject)
+	})
+}
+
+// Handle route event to the handler registered for its type, or to the default handler
+func (d *UserDataDispatcher) Handle(event *WsUserDataEvent) {
+	if handler, ok := d.handlers[event.Event]; ok {
+		handler(event)
+		return
+	}
+	if d.defaultHandler != nil {
+		d.defaultHandler(event)
+	}
+}
@@ -0,0 +1,39 @@
+package futures
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserDataDispatcher(t *testing.T) {
+	var orders []int64
+	var balances []string
+	var others []UserDataEventType
+	d := NewUserDataDispatcher().OnOrderTradeUpdate(func(update *WsOrderTradeUpdate) {
+		orders = append(orders, update.ID)
+	}).OnAccountUpdate(func(update *WsAccountUpdate) {
+		balances = append(balances, update.Balances[0].Balance)
+	}).OnDefault(func(event *WsUserDataEvent) {
+		others = append(others, event.Event)
+	})
+
+	frames := [][]byte{
+		orderTradeUpdateData(8886774, "myOrder1", OrderStatusTypeNew, "0", "0"),
+		[]byte(`{"e":"ACCOUNT_UPDATE","E":1564745798939,"T":1564745798938,"a":{"m":"ORDER","B":[{"a":"USDT","wb":"122624.12345678","cw":"100.12345678","bc":"50.12345678"}],"P":[]}}`),
+		[]byte(`{"e":"listenKeyExpired","E":1576653824250}`),
+		orderTradeUpdateData(8886774, "myOrder1", OrderStatusTypeFilled, "0.010", "0.010"),
+	}
+	for _, frame := range frames {
+		event := new(WsUserDataEvent)
+		require.NoError(t, json.Unmarshal(frame, event))
+		d.Handle(event)
+	}
+	require.Equal(t, []int64{8886774, 8886774}, orders)
+	require.Equal(t, []string{"122624.12345678"}, balances)
+	require.Equal(t, []UserDataEventType{UserDataEventTypeListenKeyExpired}, others)
+
+	// events without handler are dropped when there is no default handler
+	NewUserDataDispatcher().Handle(&WsUserDataEvent{Event: UserDataEventTypeMarginCall})
+}
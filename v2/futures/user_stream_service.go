@@ -3,8 +3,51 @@ package futures
 import (
 	"context"
 	"net/http"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+var (
+	// ListenKeyMaxAttempts is the number of attempts made for each listenKey request
+	ListenKeyMaxAttempts = 3
+	// ListenKeyRetryMinInterval is the delay before the first listenKey retry
+	ListenKeyRetryMinInterval = 500 * time.Millisecond
+	// ListenKeyRetryMaxInterval caps the delay between listenKey retries
+	ListenKeyRetryMaxInterval = 5 * time.Second
+	// ListenKeyMaxRetryAfter caps the Retry-After honoured before a listenKey retry, the requests of the
+	// ListenKeyManager run in the background and must not sleep for the length of an IP ban
+	ListenKeyMaxRetryAfter = 30 * time.Second
 )
 
+// callListenKey send a listenKey request, retrying transient failures with exponential backoff.
+// Only transport errors, rate limited or banned responses and server errors are retried, the last two after
+// their Retry-After delay up to ListenKeyMaxRetryAfter. Other errors, e.g. a DryRunError or a signing failure,
+// are returned immediately.
+func (c *Client) callListenKey(ctx context.Context, api map[string]interface{}) (data []byte, err error) {
+	b := &backoff.Backoff{
+		Min:    ListenKeyRetryMinInterval,
+		Max:    ListenKeyRetryMaxInterval,
+		Factor: 2,
+	}
+	for attempt := 1; ; attempt++ {
+		data, err = c.call(ctx, api, true)
+		if err == nil || attempt >= ListenKeyMaxAttempts {
+			return data, err
+		}
+		if !isRetryable(err) {
+			return nil, err
+		}
+		delay := min(retryDelay(err, b.Duration()), ListenKeyMaxRetryAfter)
+		c.debug("listenKey request failed (attempt %d/%d): %v, retry in %s", attempt, ListenKeyMaxAttempts, err, delay)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
 // StartUserStreamService create listen key for user stream service
 type StartUserStreamService struct {
 	c *Client
@@ -17,16 +60,17 @@ func (s *StartUserStreamService) Do(ctx context.Context, opts ...RequestOption)
 		"method": http.MethodPost,
 		"params": map[string]interface{}{},
 	}
-	data, err := s.c.call(m, true)
+	data, err := s.c.callListenKey(ctx, m)
 	if err != nil {
 		return "", err
 	}
-	j, err := newJSON(data)
-	if err != nil {
+	res := new(struct {
+		ListenKey string `json:"listenKey"`
+	})
+	if err = s.c.unmarshal(data, res); err != nil {
 		return "", err
 	}
-	listenKey = j.Get("listenKey").MustString()
-	return listenKey, nil
+	return res.ListenKey, nil
 }
 
 // KeepaliveUserStreamService update listen key
@@ -50,7 +94,7 @@ func (s *KeepaliveUserStreamService) Do(ctx context.Context, opts ...RequestOpti
 			"listenKey": s.listenKey,
 		},
 	}
-	_, err = s.c.call(m, true)
+	_, err = s.c.callListenKey(ctx, m)
 	return err
 }
 
@@ -75,6 +119,6 @@ func (s *CloseUserStreamService) Do(ctx context.Context, opts ...RequestOption)
 			"listenKey": s.listenKey,
 		},
 	}
-	_, err = s.c.call(m, true)
+	_, err = s.c.callListenKey(ctx, m)
 	return err
 }
@@ -1,8 +1,12 @@
 package futures
 
 import (
+	"errors"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/coin-quant/go-aster/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -14,6 +18,27 @@ func TestUserStreamService(t *testing.T) {
 	suite.Run(t, new(userStreamServiceTestSuite))
 }
 
+func (s *userStreamServiceTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	minInterval, maxInterval := ListenKeyRetryMinInterval, ListenKeyRetryMaxInterval
+	ListenKeyRetryMinInterval, ListenKeyRetryMaxInterval = time.Millisecond, time.Millisecond
+	s.T().Cleanup(func() {
+		ListenKeyRetryMinInterval, ListenKeyRetryMaxInterval = minInterval, maxInterval
+	})
+}
+
+func (s *userStreamServiceTestSuite) mockDoSequence(errs ...error) {
+	s.client.Client.do = s.client.do
+	s.client.HTTPClient.Transport = roundTripperFunc(s.client.do)
+	for _, err := range errs {
+		if err != nil {
+			s.client.On("do", anyHTTPRequest()).Return((*http.Response)(nil), err).Once()
+			continue
+		}
+		s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{}`), http.StatusOK), nil).Once()
+	}
+}
+
 func (s *userStreamServiceTestSuite) TestStartUserStream() {
 	data := []byte(`{
         "listenKey": "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"
@@ -57,3 +82,93 @@ func (s *userStreamServiceTestSuite) TestCloseUserStream() {
 	err := s.client.NewCloseUserStreamService().ListenKey(listenKey).Do(newContext())
 	s.r().NoError(err)
 }
+
+func (s *userStreamServiceTestSuite) TestKeepaliveUserStreamRetry() {
+	s.mockDoSequence(errors.New("connection reset"), errors.New("connection reset"), nil)
+
+	err := s.client.NewKeepaliveUserStreamService().ListenKey("dummykey").Do(newContext())
+	s.r().NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *userStreamServiceTestSuite) TestKeepaliveUserStreamRetryExhausted() {
+	s.mockDoSequence(errors.New("connection reset"), errors.New("connection reset"), errors.New("timeout"))
+
+	err := s.client.NewKeepaliveUserStreamService().ListenKey("dummykey").Do(newContext())
+	s.r().Error(err)
+	s.r().Contains(err.Error(), "timeout")
+	s.client.AssertNumberOfCalls(s.T(), "do", ListenKeyMaxAttempts)
+}
+
+func (s *userStreamServiceTestSuite) TestKeepaliveUserStreamNoRetryOnAPIError() {
+	s.mockDo([]byte(`{"code":-1125,"msg":"This listenKey does not exist."}`), nil, http.StatusBadRequest)
+
+	err := s.client.NewKeepaliveUserStreamService().ListenKey("dummykey").Do(newContext())
+	s.r().EqualError(err, "<APIError> code=-1125, msg=This listenKey does not exist.")
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *userStreamServiceTestSuite) TestKeepaliveUserStreamRetryAfter() {
+	s.client.Client.do = s.client.do
+	resp := newHTTPResponse([]byte(`{"code":-1003,"msg":"Too many requests."}`), http.StatusTooManyRequests)
+	resp.Header = http.Header{"Retry-After": []string{"1"}}
+	s.client.On("do", anyHTTPRequest()).Return(resp, nil).Once()
+	s.mockDoOnce([]byte(`{}`), nil)
+
+	start := time.Now()
+	err := s.client.NewKeepaliveUserStreamService().ListenKey("dummykey").Do(newContext())
+	s.r().NoError(err)
+	s.r().GreaterOrEqual(time.Since(start), time.Second)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *userStreamServiceTestSuite) TestKeepaliveUserStreamRetryWhenBanned() {
+	s.mockDo([]byte(`{"code":-1003,"msg":"Way too many requests; IP banned."}`), nil, http.StatusTeapot)
+
+	err := s.client.NewKeepaliveUserStreamService().ListenKey("dummykey").Do(newContext())
+	s.r().True(common.IsBanned(err))
+	s.client.AssertNumberOfCalls(s.T(), "do", ListenKeyMaxAttempts)
+}
+
+func (s *userStreamServiceTestSuite) TestKeepaliveUserStreamMaxRetryAfter() {
+	maxRetryAfter := ListenKeyMaxRetryAfter
+	ListenKeyMaxRetryAfter = 10 * time.Millisecond
+	defer func() { ListenKeyMaxRetryAfter = maxRetryAfter }()
+	for i := 0; i < ListenKeyMaxAttempts; i++ {
+		s.mockDoOnce([]byte(`{"code":-1003,"msg":"Way too many requests; IP banned."}`), nil, http.StatusTeapot)
+		s.client.ExpectedCalls[i].ReturnArguments.Get(0).(*http.Response).Header = http.Header{"Retry-After": {"3600"}}
+	}
+
+	start := time.Now()
+	err := s.client.NewKeepaliveUserStreamService().ListenKey("dummykey").Do(newContext())
+	s.r().True(common.IsBanned(err))
+	s.r().Less(time.Since(start), time.Second)
+	s.client.AssertNumberOfCalls(s.T(), "do", ListenKeyMaxAttempts)
+}
+
+func (s *userStreamServiceTestSuite) TestKeepaliveUserStreamRetryServerError() {
+	s.mockDoOnce([]byte(`<html>502 Bad Gateway</html>`), nil, http.StatusBadGateway)
+	s.mockDoOnce([]byte(`{}`), nil)
+
+	err := s.client.NewKeepaliveUserStreamService().ListenKey("dummykey").Do(newContext())
+	s.r().NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *userStreamServiceTestSuite) TestStartUserStreamNoRetryOnLocalError() {
+	// make a retry noticeable
+	ListenKeyRetryMinInterval, ListenKeyRetryMaxInterval = time.Second, time.Second
+
+	s.client.DryRun = true
+	start := time.Now()
+	_, err := s.client.NewStartUserStreamService().Do(newContext())
+	var dryRunErr *DryRunError
+	s.r().ErrorAs(err, &dryRunErr)
+
+	s.client.DryRun = false
+	s.client.PriKeyHex = "invalid"
+	_, err = s.client.NewStartUserStreamService().Do(newContext())
+	s.r().Error(err)
+	s.r().Less(time.Since(start), time.Second)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
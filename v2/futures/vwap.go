@@ -0,0 +1,43 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// VWAP return the volume weighted average price of trades, sum(price*qty)/sum(qty).
+// Trades whose price or quantity can not be parsed are ignored, zero is returned
+// when there is no trade or no volume.
+func VWAP(trades []AggTrade) decimal.Decimal {
+	notional, volume := decimal.Zero, decimal.Zero
+	for _, t := range trades {
+		price, err := decimal.NewFromString(t.Price)
+		if err != nil {
+			continue
+		}
+		qty, err := decimal.NewFromString(t.Quantity)
+		if err != nil {
+			continue
+		}
+		notional = notional.Add(price.Mul(qty))
+		volume = volume.Add(qty)
+	}
+	if volume.IsZero() {
+		return decimal.Zero
+	}
+	return notional.Div(volume)
+}
+
+// RecentVWAP return the VWAP of the last limit aggregate trades of symbol
+func (c *Client) RecentVWAP(ctx context.Context, symbol string, limit int) (decimal.Decimal, error) {
+	res, err := c.NewAggTradesService().Symbol(symbol).Limit(limit).Do(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	trades := make([]AggTrade, 0, len(res))
+	for _, t := range res {
+		trades = append(trades, *t)
+	}
+	return VWAP(trades), nil
+}
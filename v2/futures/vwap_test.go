@@ -0,0 +1,46 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type vwapTestSuite struct {
+	baseTestSuite
+}
+
+func TestVWAP(t *testing.T) {
+	suite.Run(t, new(vwapTestSuite))
+}
+
+func TestVWAPTrades(t *testing.T) {
+	trades := []AggTrade{
+		{Price: "100", Quantity: "1"},
+		{Price: "110", Quantity: "3"},
+		{Price: "90.5", Quantity: "2"},
+	}
+	// (100*1 + 110*3 + 90.5*2) / 6
+	require.Equal(t, "101.8333333333333333", VWAP(trades).String())
+
+	trades = append(trades, AggTrade{Price: "invalid", Quantity: "10"})
+	require.Equal(t, "101.8333333333333333", VWAP(trades).String())
+
+	require.True(t, VWAP(nil).IsZero())
+	require.True(t, VWAP([]AggTrade{{Price: "100", Quantity: "0"}}).IsZero())
+}
+
+func (s *vwapTestSuite) TestRecentVWAP() {
+	data := []byte(`[
+		{"a": 1, "p": "60000", "q": "0.5", "f": 1, "l": 1, "T": 1498793709153, "m": true},
+		{"a": 2, "p": "60100", "q": "1.5", "f": 2, "l": 3, "T": 1498793709154, "m": false}
+	]`)
+	s.mockDoOnce(data, nil)
+
+	vwap, err := s.client.RecentVWAP(newContext(), "BTCUSDT", 2)
+	r := s.r()
+	r.NoError(err)
+	r.Equal("60075", vwap.String())
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
@@ -3,6 +3,7 @@ package futures
 import (
 	"net/http"
 	"net/url"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -18,6 +19,10 @@ type ErrHandler func(err error)
 type WsConfig struct {
 	Endpoint string
 	Proxy    *string
+	// RawHandler receive every raw frame before it is decoded, nil to disable
+	RawHandler WsHandler
+	// Stats count the messages received, nil to disable
+	Stats *StreamStats
 }
 
 func newWsConfig(endpoint string) *WsConfig {
@@ -27,6 +32,133 @@ func newWsConfig(endpoint string) *WsConfig {
 	}
 }
 
+// WsOption configure a single stream started by a WsXxxServe function
+type WsOption func(o *wsOptions)
+
+type wsOptions struct {
+	endpoint         string
+	combinedEndpoint string
+	rawHandler       WsHandler
+	stats            *StreamStats
+}
+
+// WithWsEndpoint override the base endpoint the stream connects to, e.g. to use a local server
+func WithWsEndpoint(url string) WsOption {
+	return func(o *wsOptions) {
+		o.endpoint = url
+	}
+}
+
+// WithWsCombinedEndpoint override the base endpoint a combined stream connects to
+func WithWsCombinedEndpoint(url string) WsOption {
+	return func(o *wsOptions) {
+		o.combinedEndpoint = url
+	}
+}
+
+// WithWsClient start the stream on the endpoints of c, those of its network unless they are set with
+// Client.SetWsEndpoint and Client.SetWsCombinedEndpoint, so a client created WithTestnet streams from the testnet
+func WithWsClient(c *Client) WsOption {
+	return func(o *wsOptions) {
+		o.endpoint = c.WsEndpoint()
+		o.combinedEndpoint = c.WsCombinedEndpoint()
+	}
+}
+
+// WithWsRawHandler pass every raw frame of the stream to handler before it is decoded,
+// e.g. to log them or to handle events not modeled yet
+func WithWsRawHandler(handler WsHandler) WsOption {
+	return func(o *wsOptions) {
+		o.rawHandler = handler
+	}
+}
+
+// WithWsStats count the messages of the stream in stats, which the caller keeps to watch the stream, e.g.
+//
+//	stats := new(StreamStats)
+//	doneC, stopC, err := WsKlineServe(symbol, interval, handler, errHandler, WithWsStats(stats))
+func WithWsStats(stats *StreamStats) WsOption {
+	return func(o *wsOptions) {
+		o.stats = stats
+	}
+}
+
+func newWsOptions(opts []WsOption) *wsOptions {
+	o := new(wsOptions)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// baseEndpoint return the base endpoint of the stream, the one of UseTestnet unless it is overridden
+func (o *wsOptions) baseEndpoint() string {
+	if o.endpoint != "" {
+		return o.endpoint
+	}
+	return getWsEndpoint()
+}
+
+// baseCombinedEndpoint return the base endpoint of the combined stream, the one of UseTestnet unless it is overridden
+func (o *wsOptions) baseCombinedEndpoint() string {
+	if o.combinedEndpoint != "" {
+		return o.combinedEndpoint
+	}
+	return getCombinedEndpoint()
+}
+
+func (o *wsOptions) config(endpoint string) *WsConfig {
+	cfg := newWsConfig(endpoint)
+	cfg.RawHandler = o.rawHandler
+	cfg.Stats = o.stats
+	return cfg
+}
+
+// StreamStats hold health counters of a running stream, updated atomically as messages arrive.
+// The zero value is ready to be passed WithWsStats.
+type StreamStats struct {
+	startTime   atomic.Int64
+	messages    atomic.Uint64
+	lastMessage atomic.Int64
+}
+
+// start record the time the stream started, the one MessageRate is computed from
+func (s *StreamStats) start() {
+	s.startTime.Store(time.Now().UnixNano())
+}
+
+func (s *StreamStats) onMessage() {
+	s.messages.Add(1)
+	s.lastMessage.Store(time.Now().UnixNano())
+}
+
+// Messages return the number of messages received
+func (s *StreamStats) Messages() uint64 {
+	return s.messages.Load()
+}
+
+// LastMessageTime return the time the last message was received, zero before the first one
+func (s *StreamStats) LastMessageTime() time.Time {
+	last := s.lastMessage.Load()
+	if last == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, last)
+}
+
+// MessageRate return the average number of messages per second since the stream started, 0 before it starts
+func (s *StreamStats) MessageRate() float64 {
+	start := s.startTime.Load()
+	if start == 0 {
+		return 0
+	}
+	elapsed := time.Since(time.Unix(0, start)).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(s.Messages()) / elapsed
+}
+
 var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	proxy := http.ProxyFromEnvironment
 	if cfg.Proxy != nil {
@@ -49,6 +181,10 @@ var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (don
 	c.SetReadLimit(655350)
 	doneC = make(chan struct{})
 	stopC = make(chan struct{})
+	stats := cfg.Stats
+	if stats != nil {
+		stats.start()
+	}
 	go func() {
 		// This function will exit either on error from
 		// websocket.Conn.ReadMessage or when the stopC channel is
@@ -77,6 +213,12 @@ var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (don
 				}
 				return
 			}
+			if stats != nil {
+				stats.onMessage()
+			}
+			if cfg.RawHandler != nil {
+				cfg.RawHandler(message)
+			}
 			handler(message)
 		}
 	}()
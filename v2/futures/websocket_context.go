@@ -0,0 +1,87 @@
+package futures
+
+import (
+	"context"
+	"time"
+)
+
+// WsStopOnContext close stopC once ctx is done, so cancelling ctx closes the stream started by a WsXxxServe
+// function which then returns via doneC. stopC must not be closed by the caller afterwards.
+// It returns doneC, e.g.
+//
+//	doneC, stopC, err := WsCombinedKlineServe(pairs, handler, errHandler)
+//	if err != nil {
+//		return err
+//	}
+//	<-WsStopOnContext(ctx, doneC, stopC)
+func WsStopOnContext(ctx context.Context, doneC, stopC chan struct{}) chan struct{} {
+	go func() {
+		select {
+		case <-ctx.Done():
+			close(stopC)
+		case <-doneC:
+		}
+	}()
+	return doneC
+}
+
+// wsServeContext start the stream with serve unless ctx is already done and tie its lifecycle to ctx
+func wsServeContext(ctx context.Context, serve func() (doneC, stopC chan struct{}, err error)) (chan struct{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	doneC, stopC, err := serve()
+	if err != nil {
+		return nil, err
+	}
+	return WsStopOnContext(ctx, doneC, stopC), nil
+}
+
+// WsAggTradeServeContext is similar to WsAggTradeServe, but the stream is closed once ctx is done
+func WsAggTradeServeContext(ctx context.Context, symbol string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOption) (doneC chan struct{}, err error) {
+	return wsServeContext(ctx, func() (chan struct{}, chan struct{}, error) {
+		return WsAggTradeServe(symbol, handler, errHandler, opts...)
+	})
+}
+
+// WsMarkPriceServeContext is similar to WsMarkPriceServe, but the stream is closed once ctx is done
+func WsMarkPriceServeContext(ctx context.Context, symbol string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC chan struct{}, err error) {
+	return wsServeContext(ctx, func() (chan struct{}, chan struct{}, error) {
+		return WsMarkPriceServe(symbol, handler, errHandler, opts...)
+	})
+}
+
+// WsKlineServeContext is similar to WsKlineServe, but the stream is closed once ctx is done
+func WsKlineServeContext(ctx context.Context, symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC chan struct{}, err error) {
+	return wsServeContext(ctx, func() (chan struct{}, chan struct{}, error) {
+		return WsKlineServe(symbol, interval, handler, errHandler, opts...)
+	})
+}
+
+// WsBookTickerServeContext is similar to WsBookTickerServe, but the stream is closed once ctx is done
+func WsBookTickerServeContext(ctx context.Context, symbol string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC chan struct{}, err error) {
+	return wsServeContext(ctx, func() (chan struct{}, chan struct{}, error) {
+		return WsBookTickerServe(symbol, handler, errHandler, opts...)
+	})
+}
+
+// WsPartialDepthServeContext is similar to WsPartialDepthServe, but the stream is closed once ctx is done
+func WsPartialDepthServeContext(ctx context.Context, symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC chan struct{}, err error) {
+	return wsServeContext(ctx, func() (chan struct{}, chan struct{}, error) {
+		return WsPartialDepthServe(symbol, levels, handler, errHandler, opts...)
+	})
+}
+
+// WsDiffDepthServeContext is similar to WsDiffDepthServeWithRate, but the stream is closed once ctx is done
+func WsDiffDepthServeContext(ctx context.Context, symbol string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC chan struct{}, err error) {
+	return wsServeContext(ctx, func() (chan struct{}, chan struct{}, error) {
+		return WsDiffDepthServeWithRate(symbol, rate, handler, errHandler, opts...)
+	})
+}
+
+// WsUserDataServeContext is similar to WsUserDataServe, but the stream is closed once ctx is done
+func WsUserDataServeContext(ctx context.Context, listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOption) (doneC chan struct{}, err error) {
+	return wsServeContext(ctx, func() (chan struct{}, chan struct{}, error) {
+		return WsUserDataServe(listenKey, handler, errHandler, opts...)
+	})
+}
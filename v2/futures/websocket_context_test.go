@@ -0,0 +1,65 @@
+package futures
+
+import (
+	"context"
+	"time"
+)
+
+func (s *websocketServiceTestSuite) TestServeContextCancel() {
+	data := []byte(`{"e":"aggTrade","E":123456789,"s":"BTCUSDT","a":5933014,"p":"0.001","q":"100","f":100,"l":105,"T":123456785,"m":true}`)
+	s.mockWsServe(data, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var symbol string
+	doneC, err := WsAggTradeServeContext(ctx, "BTCUSDT", func(event *WsAggTradeEvent) {
+		symbol = event.Symbol
+	}, func(err error) {
+		s.r().FailNow("unexpected error", err)
+	})
+	s.r().NoError(err)
+	s.r().Equal("BTCUSDT", symbol)
+
+	select {
+	case <-doneC:
+		s.r().FailNow("stream stopped before ctx is cancelled")
+	case <-time.After(10 * time.Millisecond):
+	}
+	cancel()
+	select {
+	case <-doneC:
+	case <-time.After(time.Second):
+		s.r().FailNow("stream not stopped after ctx is cancelled")
+	}
+	s.assertWsServe()
+}
+
+func (s *websocketServiceTestSuite) TestServeContextDone() {
+	s.mockWsServe([]byte(`{}`), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	doneC, err := WsUserDataServeContext(ctx, "listenKey", func(event *WsUserDataEvent) {}, func(err error) {})
+	s.r().ErrorIs(err, context.Canceled)
+	s.r().Nil(doneC)
+	s.assertWsServe(0)
+
+	_, err = WsDiffDepthServeContext(context.Background(), "BTCUSDT", time.Second, func(event *WsDepthEvent) {}, func(err error) {})
+	s.r().EqualError(err, "Invalid rate")
+}
+
+func (s *websocketServiceTestSuite) TestStopOnContextStreamDone() {
+	doneC, stopC := make(chan struct{}), make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	s.r().Equal(doneC, WsStopOnContext(ctx, doneC, stopC))
+
+	// the stream stopping by itself must not close stopC once ctx is cancelled
+	close(doneC)
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	select {
+	case <-stopC:
+		s.r().FailNow("stopC closed after the stream stopped")
+	default:
+	}
+}
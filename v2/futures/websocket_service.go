@@ -9,6 +9,7 @@ import (
 
 	"github.com/bitly/go-simplejson"
 	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
 )
 
 // Endpoints
@@ -28,7 +29,11 @@ var (
 	WebsocketPongTimeout = time.Second * 10
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = true
-	// UseTestnet switch all the WS streams from production to the testnet
+	// UseTestnet switch all the WS streams from production to the testnet.
+	// It is also the default network of clients created by NewClient.
+	//
+	// Deprecated: use NewClient(..., WithTestnet()) so mainnet and testnet clients can coexist,
+	// and start the WS streams WithWsClient; it is still read by the streams started without it.
 	UseTestnet = false
 	// WebsocketTimeoutReadWriteConnection is an interval for sending ping/pong messages if WebsocketKeepalive is enabled
 	// using for websocket API (read/write)
@@ -81,9 +86,10 @@ type WsAggTradeEvent struct {
 type WsAggTradeHandler func(event *WsAggTradeEvent)
 
 // WsAggTradeServe serve websocket that push trade information that is aggregated for a single taker order.
-func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s@aggTrade", o.baseEndpoint(), strings.ToLower(symbol))
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsAggTradeEvent)
 		err := json.Unmarshal(message, &event)
@@ -97,13 +103,14 @@ func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHan
 }
 
 // WsCombinedAggTradeServe is similar to WsAggTradeServe, but it handles multiple symbols
-func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := o.baseCombinedEndpoint()
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -146,8 +153,8 @@ type WsMarkPriceEvent struct {
 // WsMarkPriceHandler handle websocket that pushes price and funding rate for a single symbol.
 type WsMarkPriceHandler func(event *WsMarkPriceEvent)
 
-func wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler, o *wsOptions) (doneC, stopC chan struct{}, err error) {
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMarkPriceEvent)
 		err := json.Unmarshal(message, &event)
@@ -161,13 +168,15 @@ func wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler Er
 }
 
 // WsMarkPriceServe serve websocket that pushes price and funding rate for a single symbol.
-func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(), strings.ToLower(symbol))
-	return wsMarkPriceServe(endpoint, handler, errHandler)
+func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s@markPrice", o.baseEndpoint(), strings.ToLower(symbol))
+	return wsMarkPriceServe(endpoint, handler, errHandler, o)
 }
 
 // WsMarkPriceServeWithRate serve websocket that pushes price and funding rate for a single symbol and rate.
-func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
 	var rateStr string
 	switch rate {
 	case 3 * time.Second:
@@ -177,12 +186,12 @@ func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkP
 	default:
 		return nil, nil, errors.New("Invalid rate")
 	}
-	endpoint := fmt.Sprintf("%s/%s@markPrice%s", getWsEndpoint(), strings.ToLower(symbol), rateStr)
-	return wsMarkPriceServe(endpoint, handler, errHandler)
+	endpoint := fmt.Sprintf("%s/%s@markPrice%s", o.baseEndpoint(), strings.ToLower(symbol), rateStr)
+	return wsMarkPriceServe(endpoint, handler, errHandler, o)
 }
 
-func wsCombinedMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsCombinedMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler, o *wsOptions) (doneC, stopC chan struct{}, err error) {
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -207,19 +216,21 @@ func wsCombinedMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHa
 }
 
 // WsCombinedMarkPriceServe is similar to WsMarkPriceServe, but it handles multiple symbols
-func WsCombinedMarkPriceServe(symbols []string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedMarkPriceServe(symbols []string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := o.baseCombinedEndpoint()
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@markPrice", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
 
-	return wsCombinedMarkPriceServe(endpoint, handler, errHandler)
+	return wsCombinedMarkPriceServe(endpoint, handler, errHandler, o)
 }
 
 // WsCombinedMarkPriceServeWithRate is similar to WsMarkPriceServeWithRate, but it for multiple symbols
-func WsCombinedMarkPriceServeWithRate(symbolLevels map[string]time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedMarkPriceServeWithRate(symbolLevels map[string]time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := o.baseCombinedEndpoint()
 	for symbol, rate := range symbolLevels {
 		var rateStr string
 		switch rate {
@@ -236,7 +247,7 @@ func WsCombinedMarkPriceServeWithRate(symbolLevels map[string]time.Duration, han
 
 	endpoint = endpoint[:len(endpoint)-1]
 
-	return wsCombinedMarkPriceServe(endpoint, handler, errHandler)
+	return wsCombinedMarkPriceServe(endpoint, handler, errHandler, o)
 }
 
 // WsAllMarkPriceEvent defines an array of websocket markPriceUpdate events.
@@ -245,8 +256,8 @@ type WsAllMarkPriceEvent []*WsMarkPriceEvent
 // WsAllMarkPriceHandler handle websocket that pushes price and funding rate for all symbol.
 type WsAllMarkPriceHandler func(event WsAllMarkPriceEvent)
 
-func wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHandler ErrHandler, o *wsOptions) (doneC, stopC chan struct{}, err error) {
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMarkPriceEvent
 		err := json.Unmarshal(message, &event)
@@ -260,13 +271,15 @@ func wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHand
 }
 
 // WsAllMarkPriceServe serve websocket that pushes price and funding rate for all symbol.
-func WsAllMarkPriceServe(handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!markPrice@arr", getWsEndpoint())
-	return wsAllMarkPriceServe(endpoint, handler, errHandler)
+func WsAllMarkPriceServe(handler WsAllMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/!markPrice@arr", o.baseEndpoint())
+	return wsAllMarkPriceServe(endpoint, handler, errHandler, o)
 }
 
 // WsAllMarkPriceServeWithRate serve websocket that pushes price and funding rate for all symbol and rate.
-func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
 	var rateStr string
 	switch rate {
 	case 3 * time.Second:
@@ -276,8 +289,8 @@ func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandl
 	default:
 		return nil, nil, errors.New("Invalid rate")
 	}
-	endpoint := fmt.Sprintf("%s/!markPrice@arr%s", getWsEndpoint(), rateStr)
-	return wsAllMarkPriceServe(endpoint, handler, errHandler)
+	endpoint := fmt.Sprintf("%s/!markPrice@arr%s", o.baseEndpoint(), rateStr)
+	return wsAllMarkPriceServe(endpoint, handler, errHandler, o)
 }
 
 // WsKlineEvent define websocket kline event
@@ -312,9 +325,10 @@ type WsKline struct {
 type WsKlineHandler func(event *WsKlineEvent)
 
 // WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
-func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s@kline_%s", o.baseEndpoint(), strings.ToLower(symbol), interval)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -328,13 +342,14 @@ func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHan
 }
 
 // WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval
-func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := o.baseCombinedEndpoint()
 	for symbol, interval := range symbolIntervalPair {
 		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -363,15 +378,16 @@ func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineH
 }
 
 // WsCombinedKlineServeMultiInterval is similar to WsCombinedKlineServe, but it supports multiple intervals per symbol
-func WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := o.baseCombinedEndpoint()
 	for symbol, intervals := range symbolIntervals {
 		for _, interval := range intervals {
 			endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 		}
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -399,6 +415,50 @@ func WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]string, hand
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// WsMultiKlineHandler handle websocket kline event of the interval
+type WsMultiKlineHandler func(interval string, event *WsKlineEvent)
+
+// WsMultiKlineServe serve the klines of several intervals of a symbol over a single combined stream,
+// the events are passed to handler with the interval of the stream they come from
+func WsMultiKlineServe(symbol string, intervals []string, handler WsMultiKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	if len(intervals) == 0 {
+		return nil, nil, errors.New("at least one interval is required")
+	}
+	seen := make(map[string]bool, len(intervals))
+	streams := make([]string, 0, len(intervals))
+	for _, interval := range intervals {
+		if _, ok := klineIntervals[interval]; !ok && interval != "1M" {
+			return nil, nil, fmt.Errorf("invalid kline interval %q", interval)
+		}
+		if seen[interval] {
+			return nil, nil, fmt.Errorf("duplicate kline interval %q", interval)
+		}
+		seen[interval] = true
+		streams = append(streams, fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval))
+	}
+	cfg := o.config(o.baseCombinedEndpoint() + strings.Join(streams, "/"))
+	wsHandler := func(message []byte) {
+		msg := new(wsCombinedStreamMessage)
+		if err := json.Unmarshal(message, msg); err != nil {
+			errHandler(err)
+			return
+		}
+		_, interval, ok := strings.Cut(msg.Stream, "@kline_")
+		if !ok || !seen[interval] {
+			errHandler(fmt.Errorf("unexpected stream %q", msg.Stream))
+			return
+		}
+		event := new(WsKlineEvent)
+		if err := json.Unmarshal(msg.Data, event); err != nil {
+			errHandler(err)
+			return
+		}
+		handler(interval, event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
 // WsContinuousKlineEvent define websocket continuous kline event
 type WsContinuousKlineEvent struct {
 	Event        string            `json:"e"`
@@ -437,12 +497,25 @@ type WsContinuousKlineSubscribeArgs struct {
 // WsContinuousKlineHandler handle websocket continuous kline event
 type WsContinuousKlineHandler func(event *WsContinuousKlineEvent)
 
+// validateContractType check contractType is one of the defined ContractType values
+func validateContractType(contractType string) error {
+	switch ContractType(strings.ToUpper(contractType)) {
+	case ContractTypePerpetual, ContractTypeCurrentQuarter, ContractTypeNextQuarter:
+		return nil
+	}
+	return fmt.Errorf("invalid contractType %q", contractType)
+}
+
 // WsContinuousKlineServe serve websocket continuous kline handler with a pair and contractType and interval like 15m, 30s
 func WsContinuousKlineServe(subscribeArgs *WsContinuousKlineSubscribeArgs, handler WsContinuousKlineHandler,
-	errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", getWsEndpoint(), strings.ToLower(subscribeArgs.Pair),
+	errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	if err = validateContractType(subscribeArgs.ContractType); err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", o.baseEndpoint(), strings.ToLower(subscribeArgs.Pair),
 		strings.ToLower(subscribeArgs.ContractType), subscribeArgs.Interval)
-	cfg := newWsConfig(endpoint)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsContinuousKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -457,14 +530,18 @@ func WsContinuousKlineServe(subscribeArgs *WsContinuousKlineSubscribeArgs, handl
 
 // WsCombinedContinuousKlineServe is similar to WsContinuousKlineServe, but it handles multiple pairs of different contractType with its interval
 func WsCombinedContinuousKlineServe(subscribeArgsList []*WsContinuousKlineSubscribeArgs,
-	handler WsContinuousKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	handler WsContinuousKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := o.baseCombinedEndpoint()
 	for _, val := range subscribeArgsList {
+		if err = validateContractType(val.ContractType); err != nil {
+			return nil, nil, err
+		}
 		endpoint += fmt.Sprintf("%s_%s@continuousKline_%s", strings.ToLower(val.Pair),
 			strings.ToLower(val.ContractType), val.Interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -505,9 +582,10 @@ type WsMiniMarketTickerEvent struct {
 type WsMiniMarketTickerHandler func(event *WsMiniMarketTickerEvent)
 
 // WsMiniMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
-func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@miniTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s@miniTicker", o.baseEndpoint(), strings.ToLower(symbol))
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMiniMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -523,13 +601,14 @@ func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, e
 // WsAllMiniMarketTickerEvent define an array of websocket mini market ticker events.
 type WsAllMiniMarketTickerEvent []*WsMiniMarketTickerEvent
 
-// WsAllMiniMarketTickerHandler handle websocket that pushes price and funding rate for all markets.
+// WsAllMiniMarketTickerHandler handle websocket that pushes 24hr rolling window mini-ticker statistics for all markets.
 type WsAllMiniMarketTickerHandler func(event WsAllMiniMarketTickerEvent)
 
-// WsAllMiniMarketTickerServe serve websocket that pushes price and funding rate for all markets.
-func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+// WsAllMiniMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for all markets.
+func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/!miniTicker@arr", o.baseEndpoint())
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -542,6 +621,22 @@ func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// WsMiniMarketsStatEvent is the spot package name of WsMiniMarketTickerEvent
+type WsMiniMarketsStatEvent = WsMiniMarketTickerEvent
+
+// WsAllMiniMarketsStatEvent is the spot package name of WsAllMiniMarketTickerEvent
+type WsAllMiniMarketsStatEvent = WsAllMiniMarketTickerEvent
+
+// WsMiniMarketsStatServe is the spot package name of WsMiniMarketTickerServe
+func WsMiniMarketsStatServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return WsMiniMarketTickerServe(symbol, handler, errHandler, opts...)
+}
+
+// WsAllMiniMarketsStatServe is the spot package name of WsAllMiniMarketTickerServe
+func WsAllMiniMarketsStatServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return WsAllMiniMarketTickerServe(handler, errHandler, opts...)
+}
+
 // WsMarketTickerEvent define websocket market ticker event.
 type WsMarketTickerEvent struct {
 	Event              string `json:"e"`
@@ -568,9 +663,10 @@ type WsMarketTickerEvent struct {
 type WsMarketTickerHandler func(event *WsMarketTickerEvent)
 
 // WsMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
-func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s@ticker", o.baseEndpoint(), strings.ToLower(symbol))
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -590,9 +686,10 @@ type WsAllMarketTickerEvent []*WsMarketTickerEvent
 type WsAllMarketTickerHandler func(event WsAllMarketTickerEvent)
 
 // WsAllMarketTickerServe serve websocket that pushes price and funding rate for all markets.
-func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/!ticker@arr", o.baseEndpoint())
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -627,9 +724,10 @@ type WsCombinedBookTickerEvent struct {
 type WsBookTickerHandler func(event *WsBookTickerEvent)
 
 // WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
-func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s@bookTicker", o.baseEndpoint(), strings.ToLower(symbol))
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -642,13 +740,14 @@ func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler Er
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := o.baseCombinedEndpoint()
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@bookTicker", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsCombinedBookTickerEvent)
 		err := json.Unmarshal(message, event)
@@ -662,9 +761,10 @@ func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, er
 }
 
 // WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
-func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/!bookTicker", o.baseEndpoint())
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -703,9 +803,10 @@ type WsLiquidationOrder struct {
 type WsLiquidationOrderHandler func(event *WsLiquidationOrderEvent)
 
 // WsLiquidationOrderServe serve websocket that pushes force liquidation order information for specific symbol.
-func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@forceOrder", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s@forceOrder", o.baseEndpoint(), strings.ToLower(symbol))
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -719,9 +820,10 @@ func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, e
 }
 
 // WsAllLiquidationOrderServe serve websocket that pushes force liquidation order information for all symbols.
-func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!forceOrder@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/!forceOrder@arr", o.baseEndpoint())
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -734,6 +836,33 @@ func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler Er
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// WsCombinedLiquidationOrderEvent define websocket combined liquidation order event.
+type WsCombinedLiquidationOrderEvent struct {
+	Data   *WsLiquidationOrderEvent `json:"data"`
+	Stream string                   `json:"stream"`
+}
+
+// WsCombinedLiquidationOrderServe is similar to WsLiquidationOrderServe, but it handles multiple symbols
+func WsCombinedLiquidationOrderServe(symbols []string, handler WsLiquidationOrderHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := o.baseCombinedEndpoint()
+	for _, s := range symbols {
+		endpoint += fmt.Sprintf("%s@forceOrder", strings.ToLower(s)) + "/"
+	}
+	endpoint = endpoint[:len(endpoint)-1]
+	cfg := o.config(endpoint)
+	wsHandler := func(message []byte) {
+		event := new(WsCombinedLiquidationOrderEvent)
+		err := json.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event.Data)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
 // WsDepthEvent define websocket depth book event
 type WsDepthEvent struct {
 	Event            string `json:"e"`
@@ -750,37 +879,38 @@ type WsDepthEvent struct {
 // WsDepthHandler handle websocket depth event
 type WsDepthHandler func(event *WsDepthEvent)
 
-func wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, o *wsOptions) (doneC, stopC chan struct{}, err error) {
 	if levels != 5 && levels != 10 && levels != 20 {
 		return nil, nil, errors.New("Invalid levels")
 	}
 	levelsStr := fmt.Sprintf("%d", levels)
-	return wsDepthServe(symbol, levelsStr, rate, handler, errHandler)
+	return wsDepthServe(symbol, levelsStr, rate, handler, errHandler, o)
 }
 
 // WsPartialDepthServe serve websocket partial depth handler.
-func WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, nil, handler, errHandler)
+func WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return wsPartialDepthServe(symbol, levels, nil, handler, errHandler, newWsOptions(opts))
 }
 
 // WsPartialDepthServeWithRate serve websocket partial depth handler with rate.
-func WsPartialDepthServeWithRate(symbol string, levels int, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, &rate, handler, errHandler)
+func WsPartialDepthServeWithRate(symbol string, levels int, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return wsPartialDepthServe(symbol, levels, &rate, handler, errHandler, newWsOptions(opts))
 }
 
 // WsDiffDepthServe serve websocket diff. depth handler.
-func WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", nil, handler, errHandler)
+func WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return wsDepthServe(symbol, "", nil, handler, errHandler, newWsOptions(opts))
 }
 
 // WsCombinedDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
-func WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := o.baseCombinedEndpoint()
 	for s, l := range symbolLevels {
 		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), l) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -820,13 +950,26 @@ func WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler
 }
 
 // WsCombinedDiffDepthServe is similar to WsDiffDepthServe, but it for multiple symbols
-func WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return wsCombinedDiffDepthServe(symbols, nil, handler, errHandler, newWsOptions(opts))
+}
+
+// WsCombinedDiffDepthServeWithRate is similar to WsDiffDepthServeWithRate, but it for multiple symbols
+func WsCombinedDiffDepthServeWithRate(symbols []string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return wsCombinedDiffDepthServe(symbols, &rate, handler, errHandler, newWsOptions(opts))
+}
+
+func wsCombinedDiffDepthServe(symbols []string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, o *wsOptions) (doneC, stopC chan struct{}, err error) {
+	rateStr, err := depthRateSuffix(rate)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := o.baseCombinedEndpoint()
 	for _, s := range symbols {
-		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(s)) + "/"
+		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), rateStr) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -865,27 +1008,36 @@ func WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandl
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsDiffDepthServeWithRate serve websocket diff. depth handler with rate.
-func WsDiffDepthServeWithRate(symbol string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", &rate, handler, errHandler)
+// WsDiffDepthServeWithRate serve websocket diff. depth handler with rate, the update speed of the stream:
+// 100ms, 250ms or 500ms.
+func WsDiffDepthServeWithRate(symbol string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	return wsDepthServe(symbol, "", &rate, handler, errHandler, newWsOptions(opts))
 }
 
-func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	var rateStr string
-	if rate != nil {
-		switch *rate {
-		case 250 * time.Millisecond:
-			rateStr = ""
-		case 500 * time.Millisecond:
-			rateStr = "@500ms"
-		case 100 * time.Millisecond:
-			rateStr = "@100ms"
-		default:
-			return nil, nil, errors.New("Invalid rate")
-		}
+// depthRateSuffix return the stream name suffix of a depth update speed: 100ms, 250ms (the default) or 500ms
+func depthRateSuffix(rate *time.Duration) (string, error) {
+	if rate == nil {
+		return "", nil
+	}
+	switch *rate {
+	case 250 * time.Millisecond:
+		return "", nil
+	case 500 * time.Millisecond:
+		return "@500ms", nil
+	case 100 * time.Millisecond:
+		return "@100ms", nil
+	default:
+		return "", errors.New("Invalid rate")
 	}
-	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(), strings.ToLower(symbol), levels, rateStr)
-	cfg := newWsConfig(endpoint)
+}
+
+func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, o *wsOptions) (doneC, stopC chan struct{}, err error) {
+	rateStr, err := depthRateSuffix(rate)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("%s/%s@depth%s%s", o.baseEndpoint(), strings.ToLower(symbol), levels, rateStr)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -946,9 +1098,10 @@ type WsBLVTBasket struct {
 type WsBLVTInfoHandler func(event *WsBLVTInfoEvent)
 
 // WsBLVTInfoServe serve BLVT info stream
-func WsBLVTInfoServe(name string, handler WsBLVTInfoHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@tokenNav", getWsEndpoint(), strings.ToUpper(name))
-	cfg := newWsConfig(endpoint)
+func WsBLVTInfoServe(name string, handler WsBLVTInfoHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s@tokenNav", o.baseEndpoint(), strings.ToUpper(name))
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBLVTInfoEvent)
 		err := json.Unmarshal(message, &event)
@@ -989,9 +1142,10 @@ type WsBLVTKline struct {
 type WsBLVTKlineHandler func(event *WsBLVTKlineEvent)
 
 // WsBLVTKlineServe serve BLVT kline stream
-func WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@nav_Kline_%s", getWsEndpoint(), strings.ToUpper(name), interval)
-	cfg := newWsConfig(endpoint)
+func WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s@nav_Kline_%s", o.baseEndpoint(), strings.ToUpper(name), interval)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBLVTKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -1024,9 +1178,10 @@ type WsComposition struct {
 type WsCompositeIndexHandler func(event *WsCompositeIndexEvent)
 
 // WsCompositiveIndexServe serve composite index information for index symbols
-func WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@compositeIndex", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s@compositeIndex", o.baseEndpoint(), strings.ToLower(symbol))
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsCompositeIndexEvent)
 		err := json.Unmarshal(message, event)
@@ -1039,6 +1194,45 @@ func WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, err
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// WsAssetIndexEvent websocket multi-assets mode asset index event
+type WsAssetIndexEvent struct {
+	Event                 string `json:"e"`
+	Time                  int64  `json:"E"`
+	Symbol                string `json:"s"`
+	Index                 string `json:"i"`
+	BidBuffer             string `json:"b"`
+	AskBuffer             string `json:"a"`
+	BidRate               string `json:"B"`
+	AskRate               string `json:"A"`
+	AutoExchangeBidBuffer string `json:"q"`
+	AutoExchangeAskBuffer string `json:"g"`
+	AutoExchangeBidRate   string `json:"Q"`
+	AutoExchangeAskRate   string `json:"G"`
+}
+
+// WsAllAssetIndexEvent define an array of websocket asset index events
+type WsAllAssetIndexEvent []*WsAssetIndexEvent
+
+// WsAssetIndexHandler websocket asset index handler
+type WsAssetIndexHandler func(event WsAllAssetIndexEvent)
+
+// WsAssetIndexServe serve asset index of all multi-assets mode assets
+func WsAssetIndexServe(handler WsAssetIndexHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/!assetIndex@arr", o.baseEndpoint())
+	cfg := o.config(endpoint)
+	wsHandler := func(message []byte) {
+		var event WsAllAssetIndexEvent
+		err := json.Unmarshal(message, &event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
 // WsUserDataEvent define user data event
 type WsUserDataEvent struct {
 	Event           UserDataEventType `json:"e"`
@@ -1069,6 +1263,7 @@ type WsUserDataEvent struct {
 
 type WsUserDataAccountConfigUpdate struct {
 	AccountConfigUpdate WsAccountConfigUpdate `json:"ac"`
+	AccountInfoUpdate   WsAccountInfoUpdate   `json:"ai"`
 }
 
 type WsUserDataAccountUpdate struct {
@@ -1084,6 +1279,7 @@ type WsUserDataOrderTradeUpdate struct {
 	OrderTradeUpdate WsOrderTradeUpdate `json:"o"`
 }
 
+// WsUserDataTradeLite define the TRADE_LITE event, a fill pushed ahead of the full ORDER_TRADE_UPDATE
 type WsUserDataTradeLite struct {
 	Symbol          string   `json:"s"`
 	OriginalQty     string   `json:"q"`
@@ -1101,6 +1297,9 @@ type WsUserDataConditionalOrderTriggerReject struct {
 	ConditionalOrderTriggerReject WsConditionalOrderTriggerReject `json:"or"`
 }
 
+// WsTradeLite is an alias of WsUserDataTradeLite
+type WsTradeLite = WsUserDataTradeLite
+
 func (w *WsUserDataTradeLite) fromSimpleJson(j *simplejson.Json) (err error) {
 	w.Symbol = j.Get("s").MustString()
 	w.OriginalQty = j.Get("q").MustString()
@@ -1218,12 +1417,46 @@ type WsOrderTradeUpdate struct {
 	GTD                  int64              `json:"gtd"` // TIF GTD order auto cancel time
 }
 
+// IsFill return true when the update report a trade of the order, see IsMaker for its liquidity side
+func (u *WsOrderTradeUpdate) IsFill() bool {
+	return u.ExecutionType == OrderExecutionTypeTrade
+}
+
+// CommissionDecimal return the commission paid for the fill in CommissionAsset, zero when none was pushed
+func (u *WsOrderTradeUpdate) CommissionDecimal() (decimal.Decimal, error) {
+	if u.Commission == "" {
+		return decimal.Zero, nil
+	}
+	return parseDecimal("commission", u.Commission)
+}
+
+// RealizedPnLDecimal return the realized profit of the fill as a decimal
+func (u *WsOrderTradeUpdate) RealizedPnLDecimal() (decimal.Decimal, error) {
+	return parseDecimal("realizedPnL", u.RealizedPnL)
+}
+
+// LastFilledQtyDecimal return the quantity of the fill as a decimal
+func (u *WsOrderTradeUpdate) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return parseDecimal("lastFilledQty", u.LastFilledQty)
+}
+
+// LastFilledPriceDecimal return the price of the fill as a decimal
+func (u *WsOrderTradeUpdate) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return parseDecimal("lastFilledPrice", u.LastFilledPrice)
+}
+
 // WsAccountConfigUpdate define account config update
 type WsAccountConfigUpdate struct {
 	Symbol   string `json:"s"`
 	Leverage int64  `json:"l"`
 }
 
+// WsAccountInfoUpdate define account info update, pushed when multi-assets mode is switched
+type WsAccountInfoUpdate struct {
+	MultiAssetsMode bool `json:"j"`
+}
+
+// WsConditionalOrderTriggerReject define conditional order rejected when triggered
 type WsConditionalOrderTriggerReject struct {
 	Symbol       string `json:"s"`
 	OrderId      int64  `json:"i"`
@@ -1234,9 +1467,10 @@ type WsConditionalOrderTriggerReject struct {
 type WsUserDataHandler func(event *WsUserDataEvent)
 
 // WsUserDataServe serve user data handler with listen key
-func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOption) (doneC, stopC chan struct{}, err error) {
+	o := newWsOptions(opts)
+	endpoint := fmt.Sprintf("%s/%s", o.baseEndpoint(), listenKey)
+	cfg := o.config(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
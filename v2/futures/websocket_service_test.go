@@ -1,6 +1,7 @@
 package futures
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -54,6 +55,73 @@ func (s *websocketServiceTestSuite) assertWsServe(count ...int) {
 	s.r().Equal(e, s.serveCount)
 }
 
+func (s *websocketServiceTestSuite) captureWsEndpoint() *string {
+	endpoint := new(string)
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		*endpoint = cfg.Endpoint
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	return endpoint
+}
+
+func (s *websocketServiceTestSuite) TestWsEndpoint() {
+	endpoint := s.captureWsEndpoint()
+	defer func() {
+		UseTestnet = false
+	}()
+
+	_, _, err := WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {})
+	s.r().NoError(err)
+	s.r().Equal(BaseWsMainUrl+"/btcusdt@aggTrade", *endpoint)
+
+	UseTestnet = true
+	_, _, err = WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {})
+	s.r().NoError(err)
+	s.r().Equal(BaseWsTestnetUrl+"/btcusdt@aggTrade", *endpoint)
+	_, _, err = WsCombinedAggTradeServe([]string{"BTCUSDT"}, func(event *WsAggTradeEvent) {}, func(err error) {})
+	s.r().NoError(err)
+	s.r().Equal(BaseCombinedTestnetURL+"btcusdt@aggTrade", *endpoint)
+
+	_, _, err = WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {},
+		WithWsEndpoint("ws://127.0.0.1:9443/ws"))
+	s.r().NoError(err)
+	s.r().Equal("ws://127.0.0.1:9443/ws/btcusdt@aggTrade", *endpoint)
+	_, _, err = WsCombinedAggTradeServe([]string{"BTCUSDT"}, func(event *WsAggTradeEvent) {}, func(err error) {},
+		WithWsCombinedEndpoint("ws://127.0.0.1:9443/stream?streams="))
+	s.r().NoError(err)
+	s.r().Equal("ws://127.0.0.1:9443/stream?streams=btcusdt@aggTrade", *endpoint)
+
+	// the override applies to the stream it is passed to only
+	_, _, err = WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {})
+	s.r().NoError(err)
+	s.r().Equal(BaseWsTestnetUrl+"/btcusdt@aggTrade", *endpoint)
+}
+
+func (s *websocketServiceTestSuite) TestWsClientEndpoint() {
+	endpoint := s.captureWsEndpoint()
+	mainnet := NewClient("user", "signer", testPrivateKey)
+	testnet := NewClient("user", "signer", testPrivateKey, WithTestnet())
+
+	_, _, err := WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {}, WithWsClient(testnet))
+	s.r().NoError(err)
+	s.r().Equal(BaseWsTestnetUrl+"/btcusdt@aggTrade", *endpoint)
+	_, _, err = WsCombinedAggTradeServe([]string{"BTCUSDT"}, func(event *WsAggTradeEvent) {}, func(err error) {},
+		WithWsClient(testnet))
+	s.r().NoError(err)
+	s.r().Equal(BaseCombinedTestnetURL+"btcusdt@aggTrade", *endpoint)
+	_, _, err = WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {}, WithWsClient(mainnet))
+	s.r().NoError(err)
+	s.r().Equal(BaseWsMainUrl+"/btcusdt@aggTrade", *endpoint)
+
+	testnet.SetWsEndpoint("ws://127.0.0.1:9443/ws")
+	_, _, err = WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {}, WithWsClient(testnet))
+	s.r().NoError(err)
+	s.r().Equal("ws://127.0.0.1:9443/ws/btcusdt@aggTrade", *endpoint)
+	_, _, err = WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {}, func(err error) {}, WithWsClient(mainnet))
+	s.r().NoError(err)
+	s.r().Equal(BaseWsMainUrl+"/btcusdt@aggTrade", *endpoint)
+}
+
 func (s *websocketServiceTestSuite) TestAggTradeServe() {
 	data := []byte(`{
 		"e": "aggTrade",
@@ -547,6 +615,45 @@ func (s *websocketServiceTestSuite) TestWsCombinedKlineServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestWsMultiKlineServe() {
+	messages := [][]byte{
+		[]byte(`{"stream":"btcusdt@kline_1m","data":{"e":"kline","E":1,"s":"BTCUSDT","k":{"t":60000,"i":"1m","c":"100.1"}}}`),
+		[]byte(`{"stream":"btcusdt@kline_1h","data":{"e":"kline","E":2,"s":"BTCUSDT","k":{"t":3600000,"i":"1h","c":"100.2"}}}`),
+		[]byte(`{"stream":"btcusdt@kline_1m","data":{"e":"kline","E":3,"s":"BTCUSDT","k":{"t":120000,"i":"1m","c":"100.3"}}}`),
+		[]byte(`{"stream":"btcusdt@kline_5m","data":{"e":"kline","E":4,"s":"BTCUSDT","k":{"t":300000,"i":"5m","c":"100.4"}}}`),
+	}
+	var endpoint string
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoint = cfg.Endpoint
+		for _, message := range messages {
+			handler(message)
+		}
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	received := map[string][]string{}
+	var errs []error
+	_, _, err := WsMultiKlineServe("BTCUSDT", []string{"1m", "1h"}, func(interval string, event *WsKlineEvent) {
+		s.r().Equal(interval, event.Kline.Interval)
+		received[interval] = append(received[interval], event.Kline.Close)
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	r := s.r()
+	r.NoError(err)
+	r.Equal(getCombinedEndpoint()+"btcusdt@kline_1m/btcusdt@kline_1h", endpoint)
+	r.Equal(map[string][]string{"1m": {"100.1", "100.3"}, "1h": {"100.2"}}, received)
+	r.Len(errs, 1)
+	r.EqualError(errs[0], `unexpected stream "btcusdt@kline_5m"`)
+
+	_, _, err = WsMultiKlineServe("BTCUSDT", []string{"1m", "7m"}, func(string, *WsKlineEvent) {}, func(error) {})
+	r.EqualError(err, `invalid kline interval "7m"`)
+	_, _, err = WsMultiKlineServe("BTCUSDT", []string{"1m", "1m"}, func(string, *WsKlineEvent) {}, func(error) {})
+	r.EqualError(err, `duplicate kline interval "1m"`)
+	_, _, err = WsMultiKlineServe("BTCUSDT", nil, func(string, *WsKlineEvent) {}, func(error) {})
+	r.EqualError(err, "at least one interval is required")
+}
+
 func (s *websocketServiceTestSuite) TestWsCombinedKlineServeMultiInterval() {
 	data := []byte(`{
 	"stream":"ethbtc@kline_1m",
@@ -680,11 +787,72 @@ func (s *websocketServiceTestSuite) TestContinuousKlineServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestContinuousKlineServeFinal() {
+	data := []byte(`{"e":"continuous_kline","E":1712730060012,"ps":"ETHUSDT","ct":"CURRENT_QUARTER","k":{"t":1712730000000,"T":1712730059999,"i":"1m","f":3916817406,"L":3916819220,"o":"3511.32","c":"3509.87","h":"3512.90","l":"3508.11","v":"1823.117","n":1815,"x":true,"q":"6398231.10733","V":"911.580","Q":"3199310.04416","B":"0"}}`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsContinuousKlineServe(&WsContinuousKlineSubscribeArgs{
+		Pair:         "ETHUSDT",
+		ContractType: string(ContractTypeCurrentQuarter),
+		Interval:     "1m",
+	}, func(event *WsContinuousKlineEvent) {
+		e := &WsContinuousKlineEvent{
+			Event:        "continuous_kline",
+			Time:         1712730060012,
+			PairSymbol:   "ETHUSDT",
+			ContractType: "CURRENT_QUARTER",
+			Kline: WsContinuousKline{
+				StartTime:            1712730000000,
+				EndTime:              1712730059999,
+				Interval:             "1m",
+				FirstTradeID:         3916817406,
+				LastTradeID:          3916819220,
+				Open:                 "3511.32",
+				Close:                "3509.87",
+				High:                 "3512.90",
+				Low:                  "3508.11",
+				Volume:               "1823.117",
+				TradeNum:             1815,
+				IsFinal:              true,
+				QuoteVolume:          "6398231.10733",
+				ActiveBuyVolume:      "911.580",
+				ActiveBuyQuoteVolume: "3199310.04416",
+			},
+		}
+		s.assertWsContinuousKlineEventEqual(e, event)
+	}, func(err error) {
+		s.r().NoError(err)
+	})
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+}
+
+func (s *websocketServiceTestSuite) TestContinuousKlineServeInvalidContractType() {
+	s.mockWsServe(nil, nil)
+	defer s.assertWsServe(0)
+
+	_, _, err := WsContinuousKlineServe(&WsContinuousKlineSubscribeArgs{
+		Pair:         "BTCUSDT",
+		ContractType: "NEXT_MONTH",
+		Interval:     "1m",
+	}, func(event *WsContinuousKlineEvent) {}, func(err error) {})
+	s.r().EqualError(err, `invalid contractType "NEXT_MONTH"`)
+
+	_, _, err = WsCombinedContinuousKlineServe([]*WsContinuousKlineSubscribeArgs{
+		{Pair: "BTCUSDT", ContractType: "PERPETUAL", Interval: "1m"},
+		{Pair: "ETHUSDT", ContractType: "", Interval: "1m"},
+	}, func(event *WsContinuousKlineEvent) {}, func(err error) {})
+	s.r().EqualError(err, `invalid contractType ""`)
+}
+
 func (s *websocketServiceTestSuite) assertWsContinuousKlineEventEqual(e, a *WsContinuousKlineEvent) {
 	r := s.r()
 	r.Equal(e.Event, a.Event, "Event")
 	r.Equal(e.Time, a.Time, "Time")
 	r.Equal(e.PairSymbol, a.PairSymbol, "PairSymbol")
+	r.Equal(e.ContractType, a.ContractType, "ContractType")
 	ek, ak := e.Kline, a.Kline
 	r.Equal(ek.StartTime, ak.StartTime, "StartTime")
 	r.Equal(ek.EndTime, ak.EndTime, "EndTime")
@@ -853,6 +1021,52 @@ func (s *websocketServiceTestSuite) TestAllMiniMarketTickerServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestAllMiniMarketsStatServe() {
+	data := []byte(`[
+		{"e":"24hrMiniTicker","E":1712730000012,"s":"BTCUSDT","c":"60123.40","o":"59001.10","h":"60550.00","l":"58810.20","v":"152340.118","q":"9087123456.78"},
+		{"e":"24hrMiniTicker","E":1712730000015,"s":"ETHUSDT","c":"3511.32","o":"3420.00","h":"3530.18","l":"3398.77","v":"1823117.004","q":"6332198310.41"}
+	]`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsAllMiniMarketsStatServe(func(event WsAllMiniMarketsStatEvent) {
+		e := []*WsMiniMarketsStatEvent{
+			{
+				Event:       "24hrMiniTicker",
+				Time:        1712730000012,
+				Symbol:      "BTCUSDT",
+				ClosePrice:  "60123.40",
+				OpenPrice:   "59001.10",
+				HighPrice:   "60550.00",
+				LowPrice:    "58810.20",
+				Volume:      "152340.118",
+				QuoteVolume: "9087123456.78",
+			},
+			{
+				Event:       "24hrMiniTicker",
+				Time:        1712730000015,
+				Symbol:      "ETHUSDT",
+				ClosePrice:  "3511.32",
+				OpenPrice:   "3420.00",
+				HighPrice:   "3530.18",
+				LowPrice:    "3398.77",
+				Volume:      "1823117.004",
+				QuoteVolume: "6332198310.41",
+			},
+		}
+		s.r().Len(event, len(e))
+		for i := range e {
+			s.assertWsMinMarketTickerEvent(e[i], event[i])
+		}
+	}, func(err error) {
+		s.r().NoError(err)
+	})
+
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+}
+
 func (s *websocketServiceTestSuite) assertWsMinMarketTickerEvent(e, a *WsMiniMarketTickerEvent) {
 	r := s.r()
 	r.Equal(e.Event, a.Event, "Event")
@@ -1195,6 +1409,60 @@ func (s *websocketServiceTestSuite) TestAllLiquidationOrderServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestCombinedLiquidationOrderServe() {
+	data := []byte(`{
+		"stream":"ethusdt@forceOrder",
+		"data":{
+			"e":"forceOrder",
+			"E":1712730000123,
+			"o":{
+				"s":"ETHUSDT",
+				"S":"BUY",
+				"o":"LIMIT",
+				"f":"IOC",
+				"q":"1.250",
+				"p":"3512.45",
+				"ap":"3510.20",
+				"X":"FILLED",
+				"l":"1.250",
+				"z":"1.250",
+				"T":1712730000120
+			}
+		}
+	}`)
+	fakeErrMsg := "fake error"
+	s.mockWsServe(data, errors.New(fakeErrMsg))
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsCombinedLiquidationOrderServe([]string{"BTCUSDT", "ETHUSDT"}, func(event *WsLiquidationOrderEvent) {
+		e := &WsLiquidationOrderEvent{
+			Event: "forceOrder",
+			Time:  1712730000123,
+			LiquidationOrder: WsLiquidationOrder{
+				Symbol:               "ETHUSDT",
+				Side:                 SideTypeBuy,
+				OrderType:            OrderTypeLimit,
+				TimeInForce:          TimeInForceTypeIOC,
+				OrigQuantity:         "1.250",
+				Price:                "3512.45",
+				AvgPrice:             "3510.20",
+				OrderStatus:          OrderStatusTypeFilled,
+				LastFilledQty:        "1.250",
+				AccumulatedFilledQty: "1.250",
+				TradeTime:            1712730000120,
+			},
+		}
+		s.assertLiquidationOrderEvent(e, event)
+	},
+		func(err error) {
+			s.r().EqualError(err, fakeErrMsg)
+		})
+
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+}
+
 func (s *websocketServiceTestSuite) assertLiquidationOrderEvent(e, a *WsLiquidationOrderEvent) {
 	r := s.r()
 	r.Equal(e.Event, a.Event, "Event")
@@ -1459,6 +1727,33 @@ func (s *websocketServiceTestSuite) TestDiffDepthServeWithInvalidRate() {
 	}
 }
 
+func (s *websocketServiceTestSuite) TestDiffDepthServeRateEndpoint() {
+	endpoint := s.captureWsEndpoint()
+	handler := func(event *WsDepthEvent) {}
+	errHandler := func(err error) {}
+
+	for rate, stream := range map[time.Duration]string{
+		100 * time.Millisecond: "btcusdt@depth@100ms",
+		250 * time.Millisecond: "btcusdt@depth",
+		500 * time.Millisecond: "btcusdt@depth@500ms",
+	} {
+		_, _, err := WsDiffDepthServeWithRate("BTCUSDT", rate, handler, errHandler)
+		s.r().NoError(err)
+		s.r().Equal(getWsEndpoint()+"/"+stream, *endpoint)
+	}
+
+	_, _, err := WsCombinedDiffDepthServeWithRate([]string{"BTCUSDT", "ETHUSDT"}, 100*time.Millisecond, handler, errHandler)
+	s.r().NoError(err)
+	s.r().Equal(getCombinedEndpoint()+"btcusdt@depth@100ms/ethusdt@depth@100ms", *endpoint)
+
+	*endpoint = ""
+	_, _, err = WsDiffDepthServeWithRate("BTCUSDT", time.Second, handler, errHandler)
+	s.r().EqualError(err, "Invalid rate")
+	_, _, err = WsCombinedDiffDepthServeWithRate([]string{"BTCUSDT"}, 200*time.Millisecond, handler, errHandler)
+	s.r().EqualError(err, "Invalid rate")
+	s.r().Empty(*endpoint)
+}
+
 func (s *websocketServiceTestSuite) TestBLVTInfoServe() {
 	data := []byte(`{
 		"e":"nav",
@@ -1660,6 +1955,54 @@ func (s *websocketServiceTestSuite) assertCompositeIndexEvent(e, a *WsCompositeI
 	}
 }
 
+func (s *websocketServiceTestSuite) TestWsAssetIndexServe() {
+	data := []byte(`[
+		{
+			"e":"assetIndexUpdate",
+			"E":1686749230000,
+			"s":"ADAUSD",
+			"i":"0.27462452",
+			"b":"0.10000000",
+			"a":"0.10000000",
+			"B":"0.24716207",
+			"A":"0.30208698",
+			"q":"0.05000000",
+			"g":"0.05000000",
+			"Q":"0.26089330",
+			"G":"0.28835575"
+		}
+	]`)
+	fakeErrMsg := "fake error"
+	s.mockWsServe(data, errors.New(fakeErrMsg))
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsAssetIndexServe(func(event WsAllAssetIndexEvent) {
+		s.r().Len(event, 1)
+		e := &WsAssetIndexEvent{
+			Event:                 "assetIndexUpdate",
+			Time:                  1686749230000,
+			Symbol:                "ADAUSD",
+			Index:                 "0.27462452",
+			BidBuffer:             "0.10000000",
+			AskBuffer:             "0.10000000",
+			BidRate:               "0.24716207",
+			AskRate:               "0.30208698",
+			AutoExchangeBidBuffer: "0.05000000",
+			AutoExchangeAskBuffer: "0.05000000",
+			AutoExchangeBidRate:   "0.26089330",
+			AutoExchangeAskRate:   "0.28835575",
+		}
+		s.r().Equal(e, event[0])
+	},
+		func(err error) {
+			s.r().EqualError(err, fakeErrMsg)
+		})
+
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+}
+
 func (s *websocketServiceTestSuite) testWsUserDataServe(data []byte, expectedEvent *WsUserDataEvent) {
 	fakeErrMsg := "fake error"
 	s.mockWsServe(data, errors.New(fakeErrMsg))
@@ -1918,6 +2261,72 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsOrderTradeUpdateFill() {
+	data := []byte(`{
+		"e":"ORDER_TRADE_UPDATE",
+		"E":1568879465651,
+		"T":1568879465650,
+		"o":{
+		  "s":"BTCUSDT",
+		  "c":"TEST",
+		  "S":"SELL",
+		  "o":"LIMIT",
+		  "f":"GTC",
+		  "q":"0.010",
+		  "p":"61000",
+		  "ap":"61000",
+		  "sp":"0",
+		  "x":"TRADE",
+		  "X":"PARTIALLY_FILLED",
+		  "i":8886774,
+		  "l":"0.004",
+		  "z":"0.004",
+		  "L":"61000",
+		  "N":"USDT",
+		  "n":"0.04880000",
+		  "T":1568879465651,
+		  "t":123,
+		  "b":"0",
+		  "a":"366",
+		  "m":true,
+		  "R":true,
+		  "wt":"CONTRACT_PRICE",
+		  "ot":"LIMIT",
+		  "ps":"BOTH",
+		  "cp":false,
+		  "rp":"4.25000000"
+		}
+	}`)
+	event := new(WsUserDataEvent)
+	s.r().NoError(json.Unmarshal(data, event))
+	update := &event.OrderTradeUpdate
+	s.r().True(update.IsFill())
+	s.r().True(update.IsMaker)
+
+	commission, err := update.CommissionDecimal()
+	s.r().NoError(err)
+	s.r().Equal("0.0488", commission.String())
+	s.r().Equal("USDT", update.CommissionAsset)
+	pnl, err := update.RealizedPnLDecimal()
+	s.r().NoError(err)
+	s.r().Equal("4.25", pnl.String())
+	qty, err := update.LastFilledQtyDecimal()
+	s.r().NoError(err)
+	s.r().Equal("0.004", qty.String())
+	price, err := update.LastFilledPriceDecimal()
+	s.r().NoError(err)
+	s.r().Equal("61000", price.String())
+
+	// commission is not pushed for orders without fill
+	newOrder := &WsOrderTradeUpdate{ExecutionType: OrderExecutionTypeNew, RealizedPnL: "x"}
+	s.r().False(newOrder.IsFill())
+	commission, err = newOrder.CommissionDecimal()
+	s.r().NoError(err)
+	s.r().True(commission.IsZero())
+	_, err = newOrder.RealizedPnLDecimal()
+	s.r().ErrorContains(err, `invalid realizedPnL "x"`)
+}
+
 func (s *websocketServiceTestSuite) TestWsUserDataServeAccountConfigUpdate() {
 	data := []byte(`{
 		"e":"ACCOUNT_CONFIG_UPDATE",
@@ -1942,6 +2351,54 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeAccountConfigUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeAccountConfigUpdateMultiAssetsMode() {
+	data := []byte(`{
+		"e":"ACCOUNT_CONFIG_UPDATE",
+		"E":1611646737479,
+		"T":1611646737476,
+		"ai":{
+		"j":true
+		}
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           UserDataEventTypeAccountConfigUpdate,
+		Time:            1611646737479,
+		TransactionTime: 1611646737476,
+		WsUserDataAccountConfigUpdate: WsUserDataAccountConfigUpdate{
+			AccountInfoUpdate: WsAccountInfoUpdate{
+				MultiAssetsMode: true,
+			},
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
+func (s *websocketServiceTestSuite) TestWsUserDataServeConditionalOrderTriggerReject() {
+	data := []byte(`{
+		"e":"CONDITIONAL_ORDER_TRIGGER_REJECT",
+		"E":1685517224945,
+		"T":1685517224955,
+		"or":{
+			"s":"ETHUSDT",
+			"i":155618472834,
+			"r":"Due to the order could not be filled immediately, the FOK order has been rejected."
+		}
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           UserDataEventTypeConditionalOrderTriggerReject,
+		Time:            1685517224945,
+		TransactionTime: 1685517224955,
+		WsUserDataConditionalOrderTriggerReject: WsUserDataConditionalOrderTriggerReject{
+			ConditionalOrderTriggerReject: WsConditionalOrderTriggerReject{
+				Symbol:       "ETHUSDT",
+				OrderId:      155618472834,
+				RejectReason: "Due to the order could not be filled immediately, the FOK order has been rejected.",
+			},
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
 func (s *websocketServiceTestSuite) TestWsUserDataServeTradeLite() {
 	data := []byte(`{
 		"e":"TRADE_LITE",             
@@ -1980,6 +2437,34 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeTradeLite() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsTradeLite() {
+	data := []byte(`{"e":"TRADE_LITE","E":1721895408092,"T":1721895408214,"s":"BTCUSDT","q":"0.001","p":"0","m":true,
+		"c":"z8hcUoOsqEdKMeKPSABslD","S":"SELL","L":"64089.20","l":"0.040","t":109100866,"i":8886774}`)
+	expected := WsTradeLite{
+		Symbol:          "BTCUSDT",
+		OriginalQty:     "0.001",
+		OriginalPrice:   "0",
+		IsMaker:         true,
+		ClientOrderID:   "z8hcUoOsqEdKMeKPSABslD",
+		Side:            SideTypeSell,
+		LastFilledPrice: "64089.20",
+		LastFilledQty:   "0.040",
+		TradeID:         109100866,
+		OrderID:         8886774,
+	}
+
+	j, err := newJSON(data)
+	s.r().NoError(err)
+	var tradeLite WsTradeLite
+	s.r().NoError(tradeLite.fromSimpleJson(j))
+	s.r().Equal(expected, tradeLite)
+
+	event := new(WsUserDataEvent)
+	s.r().NoError(json.Unmarshal(data, event))
+	s.r().Equal(UserDataEventTypeTradeLite, event.Event)
+	s.r().Equal(expected, event.WsUserDataTradeLite)
+}
+
 func (s *websocketServiceTestSuite) assertUserDataEvent(e, a *WsUserDataEvent) {
 	r := s.r()
 	r.Equal(e.Event, a.Event, "Event")
@@ -1993,6 +2478,8 @@ func (s *websocketServiceTestSuite) assertUserDataEvent(e, a *WsUserDataEvent) {
 	s.assertAccountUpdate(e.AccountUpdate, a.AccountUpdate)
 	s.assertOrderTradeUpdate(e.OrderTradeUpdate, a.OrderTradeUpdate)
 	s.assertAccountConfigUpdate(e.AccountConfigUpdate, a.AccountConfigUpdate)
+	r.Equal(e.AccountInfoUpdate, a.AccountInfoUpdate, "AccountInfoUpdate")
+	r.Equal(e.ConditionalOrderTriggerReject, a.ConditionalOrderTriggerReject, "ConditionalOrderTriggerReject")
 	s.assertTradeLite(e.WsUserDataTradeLite, a.WsUserDataTradeLite)
 }
 
@@ -0,0 +1,110 @@
+package futures
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// defaultWsServe keep the real wsServe, websocket service tests replace the package variable
+var defaultWsServe = wsServe
+
+func newWsTestServer(t *testing.T, messages int) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		for i := 0; i < messages; i++ {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"i":%d}`, i))); err != nil {
+				return
+			}
+		}
+		// keep the connection open until the client closes it
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func TestWsStreamStats(t *testing.T) {
+	const messages = 25
+	server := newWsTestServer(t, messages)
+	defer server.Close()
+
+	var received atomic.Int32
+	allReceived := make(chan struct{})
+	handler := func(message []byte) {
+		if received.Add(1) == messages {
+			close(allReceived)
+		}
+	}
+	start := time.Now()
+	stats := new(StreamStats)
+	cfg := newWsOptions([]WsOption{WithWsStats(stats)}).config("ws" + strings.TrimPrefix(server.URL, "http"))
+	doneC, stopC, err := defaultWsServe(cfg, handler, func(err error) {})
+	require.NoError(t, err)
+
+	select {
+	case <-allReceived:
+	case <-time.After(5 * time.Second):
+		t.Fatal("messages not received")
+	}
+	require.Equal(t, uint64(messages), stats.Messages())
+	require.WithinDuration(t, time.Now(), stats.LastMessageTime(), time.Since(start))
+	require.Positive(t, stats.MessageRate())
+
+	close(stopC)
+	<-doneC
+	require.Equal(t, uint64(messages), stats.Messages())
+}
+
+func TestStreamStatsEmpty(t *testing.T) {
+	stats := new(StreamStats)
+	require.Zero(t, stats.Messages())
+	require.True(t, stats.LastMessageTime().IsZero())
+	require.Zero(t, stats.MessageRate())
+}
+
+func TestWsRawHandler(t *testing.T) {
+	const messages = 3
+	server := newWsTestServer(t, messages)
+	defer server.Close()
+
+	var raw []string
+	var events int
+	allReceived := make(chan struct{})
+	rawHandler := WithWsRawHandler(func(message []byte) {
+		raw = append(raw, string(message))
+	})
+	doneC, stopC, err := WsAggTradeServe("BTCUSDT", func(event *WsAggTradeEvent) {
+		// the raw frame is passed before the typed handler
+		events++
+		require.Len(t, raw, events)
+		if events == messages {
+			close(allReceived)
+		}
+	}, func(err error) {}, WithWsEndpoint("ws"+strings.TrimPrefix(server.URL, "http")), rawHandler)
+	require.NoError(t, err)
+
+	select {
+	case <-allReceived:
+	case <-time.After(5 * time.Second):
+		t.Fatal("messages not received")
+	}
+	close(stopC)
+	<-doneC
+	require.Equal(t, []string{`{"i":0}`, `{"i":1}`, `{"i":2}`}, raw)
+}
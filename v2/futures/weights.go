@@ -0,0 +1,524 @@
+package futures
+
+import "strings"
+
+// The request weights of the REST services are the ones documented by the exchange, they are counted against
+// the REQUEST_WEIGHT limit reported in ExchangeInfo.RateLimits. The services of the WS API are not listed.
+
+// weightedService is implemented by the REST services
+type weightedService interface {
+	Weight() int
+}
+
+// weightedServices hold a zero value of each REST service, its weight is the one of a request with the default parameters
+var weightedServices = map[string]weightedService{
+	"AccountConfigService":             &AccountConfigService{},
+	"AggTradesService":                 &AggTradesService{},
+	"ApiTradingStatusService":          &ApiTradingStatusService{},
+	"AssetIndexService":                &AssetIndexService{},
+	"BasisService":                     &BasisService{},
+	"CancelAllOpenOrdersService":       &CancelAllOpenOrdersService{},
+	"CancelMultiplesOrdersService":     &CancelMultiplesOrdersService{},
+	"CancelOrderService":               &CancelOrderService{},
+	"ChangeLeverageService":            &ChangeLeverageService{},
+	"ChangeMarginTypeService":          &ChangeMarginTypeService{},
+	"ChangeMultiAssetModeService":      &ChangeMultiAssetModeService{},
+	"ChangePositionModeService":        &ChangePositionModeService{},
+	"CloseUserStreamService":           &CloseUserStreamService{},
+	"CommissionRateService":            &CommissionRateService{},
+	"ConstituentsService":              &ConstituentsService{},
+	"ContinuousKlinesService":          &ContinuousKlinesService{},
+	"ConvertAcceptService":             &ConvertAcceptService{},
+	"ConvertStatusService":             &ConvertStatusService{},
+	"CreateBatchOrdersService":         &CreateBatchOrdersService{},
+	"CreateConvertQuoteService":        &CreateConvertQuoteService{},
+	"CreateOrderService":               &CreateOrderService{},
+	"DeliveryPriceService":             &DeliveryPriceService{},
+	"DepthService":                     &DepthService{},
+	"ExchangeInfoService":              &ExchangeInfoService{},
+	"FeeBurnService":                   &FeeBurnService{},
+	"FundingRateInfoService":           &FundingRateInfoService{},
+	"FundingRateService":               &FundingRateService{},
+	"GetAccountService":                &GetAccountService{},
+	"GetBalanceService":                &GetBalanceService{},
+	"GetFeeBurnService":                &GetFeeBurnService{},
+	"GetIncomeHistoryService":          &GetIncomeHistoryService{},
+	"GetLeverageBracketService":        &GetLeverageBracketService{},
+	"GetMultiAssetModeService":         &GetMultiAssetModeService{},
+	"GetOpenInterestService":           &GetOpenInterestService{},
+	"GetOpenOrderService":              &GetOpenOrderService{},
+	"GetOrderService":                  &GetOrderService{},
+	"GetPositionMarginHistoryService":  &GetPositionMarginHistoryService{},
+	"GetPositionModeService":           &GetPositionModeService{},
+	"GetPositionRiskService":           &GetPositionRiskService{},
+	"GetRebateNewUserService":          &GetRebateNewUserService{},
+	"HistoricalTradesService":          &HistoricalTradesService{},
+	"IndexInfoService":                 &IndexInfoService{},
+	"IndexPriceKlinesService":          &IndexPriceKlinesService{},
+	"KeepaliveUserStreamService":       &KeepaliveUserStreamService{},
+	"KlinesService":                    &KlinesService{},
+	"ListAccountTradeService":          &ListAccountTradeService{},
+	"ListBookTickersService":           &ListBookTickersService{},
+	"ListConvertExchangeInfoService":   &ListConvertExchangeInfoService{},
+	"ListLiquidationOrdersService":     &ListLiquidationOrdersService{},
+	"ListOpenOrdersService":            &ListOpenOrdersService{},
+	"ListOrdersService":                &ListOrdersService{},
+	"ListPriceChangeStatsService":      &ListPriceChangeStatsService{},
+	"ListPricesService":                &ListPricesService{},
+	"ListUserLiquidationOrdersService": &ListUserLiquidationOrdersService{},
+	"LongShortRatioService":            &LongShortRatioService{},
+	"LvtKlinesService":                 &LvtKlinesService{},
+	"MarkPriceKlinesService":           &MarkPriceKlinesService{},
+	"ModifyBatchOrdersService":         &ModifyBatchOrdersService{},
+	"ModifyOrderService":               &ModifyOrderService{},
+	"OpenInterestStatisticsService":    &OpenInterestStatisticsService{},
+	"PingService":                      &PingService{},
+	"PremiumIndexKlinesService":        &PremiumIndexKlinesService{},
+	"PremiumIndexService":              &PremiumIndexService{},
+	"RecentTradesService":              &RecentTradesService{},
+	"ServerTimeService":                &ServerTimeService{},
+	"SetServerTimeService":             &SetServerTimeService{},
+	"StartUserStreamService":           &StartUserStreamService{},
+	"SymbolConfigService":              &SymbolConfigService{},
+	"TakerLongShortRatioService":       &TakerLongShortRatioService{},
+	"TopLongShortAccountRatioService":  &TopLongShortAccountRatioService{},
+	"TopLongShortPositionRatioService": &TopLongShortPositionRatioService{},
+	"UpdatePositionMarginService":      &UpdatePositionMarginService{},
+}
+
+// EstimateWeight return the request weight of the service named service, e.g. "DepthService" or "Depth",
+// sent with its default parameters. It returns 0 for an unknown service.
+func EstimateWeight(service string) int {
+	if !strings.HasSuffix(service, "Service") {
+		service += "Service"
+	}
+	s, ok := weightedServices[service]
+	if !ok {
+		return 0
+	}
+	return s.Weight()
+}
+
+// depthWeight return the weight of a depth request, 500 levels by default
+func depthWeight(limit *int) int {
+	l := 500
+	if limit != nil {
+		l = *limit
+	}
+	switch {
+	case l <= 50:
+		return 2
+	case l <= 100:
+		return 5
+	case l <= 500:
+		return 10
+	default:
+		return 20
+	}
+}
+
+// klinesWeight return the weight of a klines request, 500 klines by default
+func klinesWeight(limit *int) int {
+	l := 500
+	if limit != nil {
+		l = *limit
+	}
+	switch {
+	case l < 100:
+		return 1
+	case l < 500:
+		return 2
+	case l <= 1000:
+		return 5
+	default:
+		return 10
+	}
+}
+
+// Weight return the request weight of the service
+func (s *AccountConfigService) Weight() int {
+	return 5
+}
+
+// Weight return the request weight of the service
+func (s *AggTradesService) Weight() int {
+	return 20
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *ApiTradingStatusService) Weight() int {
+	if s.symbol == "" {
+		return 10
+	}
+	return 1
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *AssetIndexService) Weight() int {
+	if s.symbol == nil {
+		return 10
+	}
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *BasisService) Weight() int {
+	return 0
+}
+
+// Weight return the request weight of the service
+func (s *CancelAllOpenOrdersService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *CancelMultiplesOrdersService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *CancelOrderService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *ChangeLeverageService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *ChangeMarginTypeService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *ChangeMultiAssetModeService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *ChangePositionModeService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *CloseUserStreamService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *CommissionRateService) Weight() int {
+	return 20
+}
+
+// Weight return the request weight of the service
+func (s *ConstituentsService) Weight() int {
+	return 2
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *ContinuousKlinesService) Weight() int {
+	return klinesWeight(s.limit)
+}
+
+// Weight return the request weight of the service
+func (s *ConvertAcceptService) Weight() int {
+	return 200
+}
+
+// Weight return the request weight of the service
+func (s *ConvertStatusService) Weight() int {
+	return 50
+}
+
+// Weight return the request weight of the service
+func (s *CreateBatchOrdersService) Weight() int {
+	return 5
+}
+
+// Weight return the request weight of the service
+func (s *CreateConvertQuoteService) Weight() int {
+	return 50
+}
+
+// Weight return the request weight of the service
+func (s *CreateOrderService) Weight() int {
+	return 0
+}
+
+// Weight return the request weight of the service
+func (s *DeliveryPriceService) Weight() int {
+	return 0
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *DepthService) Weight() int {
+	return depthWeight(s.limit)
+}
+
+// Weight return the request weight of the service
+func (s *ExchangeInfoService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *FeeBurnService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *FundingRateInfoService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *FundingRateService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *GetAccountService) Weight() int {
+	return 5
+}
+
+// Weight return the request weight of the service
+func (s *GetBalanceService) Weight() int {
+	return 5
+}
+
+// Weight return the request weight of the service
+func (s *GetFeeBurnService) Weight() int {
+	return 30
+}
+
+// Weight return the request weight of the service
+func (s *GetIncomeHistoryService) Weight() int {
+	return 30
+}
+
+// Weight return the request weight of the service
+func (s *GetLeverageBracketService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *GetMultiAssetModeService) Weight() int {
+	return 30
+}
+
+// Weight return the request weight of the service
+func (s *GetOpenInterestService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *GetOpenOrderService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *GetOrderService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *GetPositionMarginHistoryService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *GetPositionModeService) Weight() int {
+	return 30
+}
+
+// Weight return the request weight of the service
+func (s *GetPositionRiskService) Weight() int {
+	return 5
+}
+
+// Weight return the request weight of the service
+func (s *GetRebateNewUserService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *HistoricalTradesService) Weight() int {
+	return 20
+}
+
+// Weight return the request weight of the service
+func (s *IndexInfoService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *IndexPriceKlinesService) Weight() int {
+	return klinesWeight(s.limit)
+}
+
+// Weight return the request weight of the service
+func (s *KeepaliveUserStreamService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *KlinesService) Weight() int {
+	return klinesWeight(s.limit)
+}
+
+// Weight return the request weight of the service
+func (s *ListAccountTradeService) Weight() int {
+	return 5
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *ListBookTickersService) Weight() int {
+	if s.symbol == nil {
+		return 5
+	}
+	return 2
+}
+
+// Weight return the request weight of the service
+func (s *ListConvertExchangeInfoService) Weight() int {
+	return 20
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *ListLiquidationOrdersService) Weight() int {
+	if s.symbol == nil {
+		return 50
+	}
+	return 20
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *ListOpenOrdersService) Weight() int {
+	if s.symbol == "" {
+		return 40
+	}
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *ListOrdersService) Weight() int {
+	return 5
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *ListPriceChangeStatsService) Weight() int {
+	if s.symbol == nil {
+		return 40
+	}
+	return 1
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *ListPricesService) Weight() int {
+	if s.symbol == nil {
+		return 2
+	}
+	return 1
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *ListUserLiquidationOrdersService) Weight() int {
+	if s.symbol == nil {
+		return 50
+	}
+	return 20
+}
+
+// Weight return the request weight of the service
+func (s *LongShortRatioService) Weight() int {
+	return 0
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *LvtKlinesService) Weight() int {
+	if s.limit == nil {
+		return klinesWeight(nil)
+	}
+	limit := int(*s.limit)
+	return klinesWeight(&limit)
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *MarkPriceKlinesService) Weight() int {
+	return klinesWeight(s.limit)
+}
+
+// Weight return the request weight of the service
+func (s *ModifyBatchOrdersService) Weight() int {
+	return 5
+}
+
+// Weight return the request weight of the service
+func (s *ModifyOrderService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *OpenInterestStatisticsService) Weight() int {
+	return 0
+}
+
+// Weight return the request weight of the service
+func (s *PingService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service, it depends on the parameters
+func (s *PremiumIndexKlinesService) Weight() int {
+	return klinesWeight(s.limit)
+}
+
+// Weight return the request weight of the service
+func (s *PremiumIndexService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *RecentTradesService) Weight() int {
+	return 5
+}
+
+// Weight return the request weight of the service
+func (s *ServerTimeService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *SetServerTimeService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *StartUserStreamService) Weight() int {
+	return 1
+}
+
+// Weight return the request weight of the service
+func (s *SymbolConfigService) Weight() int {
+	return 5
+}
+
+// Weight return the request weight of the service
+func (s *TakerLongShortRatioService) Weight() int {
+	return 0
+}
+
+// Weight return the request weight of the service
+func (s *TopLongShortAccountRatioService) Weight() int {
+	return 0
+}
+
+// Weight return the request weight of the service
+func (s *TopLongShortPositionRatioService) Weight() int {
+	return 0
+}
+
+// Weight return the request weight of the service
+func (s *UpdatePositionMarginService) Weight() int {
+	return 1
+}
@@ -0,0 +1,31 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateWeight(t *testing.T) {
+	require.Equal(t, 1, EstimateWeight("ExchangeInfoService"))
+	require.Equal(t, 1, EstimateWeight("ExchangeInfo"))
+	require.Equal(t, 10, EstimateWeight("DepthService"))
+	require.Equal(t, 5, EstimateWeight("Klines"))
+	require.Equal(t, 40, EstimateWeight("ListOpenOrdersService"))
+	require.Equal(t, 0, EstimateWeight("UnknownService"))
+}
+
+func TestServiceWeight(t *testing.T) {
+	c := NewClient("user", "signer", testPrivateKey)
+	for limit, weight := range map[int]int{5: 2, 10: 2, 20: 2, 50: 2, 100: 5, 500: 10, 1000: 20} {
+		require.Equal(t, weight, c.NewDepthService().Symbol("BTCUSDT").Limit(limit).Weight(), "limit %d", limit)
+	}
+	require.Equal(t, 10, c.NewDepthService().Symbol("BTCUSDT").Weight())
+	require.Equal(t, 1, c.NewExchangeInfoService().Weight())
+
+	require.Equal(t, 1, c.NewKlinesService().Limit(99).Weight())
+	require.Equal(t, 10, c.NewKlinesService().Limit(1500).Weight())
+	require.Equal(t, 1, c.NewListOpenOrdersService().Symbol("BTCUSDT").Weight())
+	require.Equal(t, 1, c.NewListPricesService().Symbol("BTCUSDT").Weight())
+	require.Equal(t, 2, c.NewListPricesService().Weight())
+}